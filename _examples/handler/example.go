@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/disgoorg/disgo"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/handler"
+	"github.com/disgoorg/disgo/handler/middleware"
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	token   = os.Getenv("disgo_token")
+	guildID = snowflake.GetEnv("disgo_guild_id")
+
+	commands = []discord.ApplicationCommandCreate{
+		discord.SlashCommandCreate{
+			Name:        "ticket",
+			Description: "manage tickets",
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        "open",
+					Description: "opens a new ticket",
+				},
+			},
+		},
+	}
+)
+
+func main() {
+	log.SetLevel(log.LevelDebug)
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	r := handler.New()
+	r.Use(middleware.Recover, middleware.Logger)
+	r.Route("/ticket", func(r handler.Router) {
+		r.Command("/open", func(e *handler.CommandEvent) error {
+			return e.CreateMessage(discord.NewMessageCreateBuilder().
+				SetContent("ticket opened").
+				AddActionRow(discord.NewPrimaryButton("Close", "/ticket/"+e.ID().String()+"/close")).
+				Build(),
+			)
+		})
+		r.With(middleware.RequirePermissions(discord.PermissionManageMessages)).Component("/{id}/close", func(e *handler.ComponentEvent) error {
+			return e.UpdateMessage(discord.NewMessageUpdateBuilder().
+				SetContentf("ticket %s closed", e.Vars["id"]).
+				ClearContainerComponents().
+				Build(),
+			)
+		})
+	})
+	r.Error(func(e *handler.InteractionEvent, err error) {
+		if err == middleware.ErrMissingPermissions {
+			_ = e.Respond(discord.InteractionResponseTypeCreateMessage, discord.NewMessageCreateBuilder().
+				SetContent("you are not allowed to do this").
+				SetEphemeral(true).
+				Build(),
+			)
+			return
+		}
+		log.Errorf("error while handling interaction %s: %s", e.Path, err)
+	})
+
+	client, err := disgo.New(token,
+		bot.WithGatewayConfigOpts(gateway.WithIntents(gateway.IntentsNone)),
+		bot.WithEventListeners(r),
+	)
+	if err != nil {
+		log.Fatal("error while building disgo instance: ", err)
+	}
+
+	defer client.Close(context.TODO())
+
+	if _, err = client.Rest().SetGuildCommands(client.ApplicationID(), guildID, commands); err != nil {
+		log.Fatal("error while registering commands: ", err)
+	}
+
+	if err = client.OpenGateway(context.TODO()); err != nil {
+		log.Fatal("error while connecting to gateway: ", err)
+	}
+
+	log.Info("example is now running. Press CTRL-C to exit.")
+	s := make(chan os.Signal, 1)
+	signal.Notify(s, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	<-s
+}
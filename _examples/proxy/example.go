@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/disgoorg/disgo/rest/proxy"
+	"github.com/disgoorg/log"
+)
+
+var (
+	token   = os.Getenv("disgo_token")
+	address = os.Getenv("disgo_proxy_address")
+)
+
+func main() {
+	log.SetLevel(log.LevelInfo)
+	log.Info("starting rest proxy...")
+
+	// clients can now use rest.NewClient("", rest.WithURL("http://<address>")) to send requests through the proxy
+	p := proxy.New(token, proxy.WithLogger(log.Default()))
+	defer p.Close(context.TODO())
+
+	server := &http.Server{
+		Addr:    address,
+		Handler: p,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("error while running rest proxy: ", err)
+		}
+	}()
+
+	log.Infof("rest proxy is listening on %s. Press CTRL-C to exit.", address)
+	s := make(chan os.Signal, 1)
+	signal.Notify(s, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	<-s
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = server.Shutdown(ctx)
+}
@@ -0,0 +1,226 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ AuditLogCorrelator = (*auditLogCorrelatorImpl)(nil)
+
+// NewAuditLogCorrelator returns a new AuditLogCorrelator with the AuditLogCorrelatorConfigOpt(s) applied.
+// It needs to be added as EventListener to the EventManager.
+func NewAuditLogCorrelator(client Client, opts ...AuditLogCorrelatorConfigOpt) AuditLogCorrelator {
+	config := DefaultAuditLogCorrelatorConfig()
+	config.Apply(opts)
+
+	return &auditLogCorrelatorImpl{
+		client:  client,
+		config:  *config,
+		pending: map[snowflake.ID][]*pendingCorrelation{},
+		used:    map[snowflake.ID]time.Time{},
+	}
+}
+
+// AuditLogCorrelatable is implemented by Event(s) which can be correlated with the audit log entry of their action, for example a member leaving the guild with a kick.
+type AuditLogCorrelatable interface {
+	Event
+	// AuditLogTarget returns the guild, the ID of the target & the action type of the audit log entry to look for.
+	AuditLogTarget() (guildID snowflake.ID, targetID snowflake.ID, actionType discord.AuditLogEvent)
+	// CorrelatedEvent returns the Event which is dispatched once the audit log entry was found.
+	// It should implement AuditLogCorrelated, so it isn't correlated again when it is dispatched.
+	CorrelatedEvent(entry discord.AuditLogEntry) Event
+}
+
+// AuditLogCorrelated is implemented by Event(s) dispatched by the AuditLogCorrelator.
+// The AuditLogCorrelator ignores them even if they embed an AuditLogCorrelatable Event.
+type AuditLogCorrelated interface {
+	Event
+	// CorrelatedAuditLogEntry returns the audit log entry the Event was correlated with.
+	CorrelatedAuditLogEntry() discord.AuditLogEntry
+}
+
+// AuditLogCorrelator finds out who is responsible for Event(s) like kicks, bans or deleted roles.
+// For every AuditLogCorrelatable Event with a configured action type it fetches the recent audit log entries of the guild,
+// matches them by target, action type & time & dispatches the correlated Event with the found entry.
+// Event(s) of the same guild received within AuditLogCorrelatorConfig.Delay are matched with a single request per action type.
+// This requires the discord.PermissionViewAuditLog.
+type AuditLogCorrelator interface {
+	EventListener
+
+	// Close stops all pending correlations.
+	Close()
+}
+
+type pendingCorrelation struct {
+	event      AuditLogCorrelatable
+	targetID   snowflake.ID
+	actionType discord.AuditLogEvent
+	receivedAt time.Time
+	attempts   int
+}
+
+type auditLogCorrelatorImpl struct {
+	client Client
+	config AuditLogCorrelatorConfig
+
+	mu      sync.Mutex
+	pending map[snowflake.ID][]*pendingCorrelation
+	timers  map[snowflake.ID]*time.Timer
+	// used contains the IDs of the already matched entries, so they don't get matched twice
+	used   map[snowflake.ID]time.Time
+	closed bool
+}
+
+func (c *auditLogCorrelatorImpl) OnEvent(event Event) {
+	// the correlated events are dispatched to the same EventManager
+	if _, ok := event.(AuditLogCorrelated); ok {
+		return
+	}
+	correlatable, ok := event.(AuditLogCorrelatable)
+	if !ok {
+		return
+	}
+	guildID, targetID, actionType := correlatable.AuditLogTarget()
+	if !c.correlates(actionType) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.enqueue(guildID, &pendingCorrelation{
+		event:      correlatable,
+		targetID:   targetID,
+		actionType: actionType,
+		receivedAt: time.Now(),
+	})
+}
+
+func (c *auditLogCorrelatorImpl) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for _, timer := range c.timers {
+		timer.Stop()
+	}
+	c.timers = nil
+	c.pending = map[snowflake.ID][]*pendingCorrelation{}
+}
+
+func (c *auditLogCorrelatorImpl) correlates(actionType discord.AuditLogEvent) bool {
+	for _, t := range c.config.ActionTypes {
+		if t == actionType {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue adds the pendingCorrelation & schedules fetching the audit log of the guild if it isn't already. The lock must be held.
+func (c *auditLogCorrelatorImpl) enqueue(guildID snowflake.ID, correlation *pendingCorrelation) {
+	if c.closed {
+		return
+	}
+	c.pending[guildID] = append(c.pending[guildID], correlation)
+	if _, ok := c.timers[guildID]; ok {
+		return
+	}
+	if c.timers == nil {
+		c.timers = map[snowflake.ID]*time.Timer{}
+	}
+	c.timers[guildID] = time.AfterFunc(c.config.Delay, func() {
+		c.correlate(guildID)
+	})
+}
+
+func (c *auditLogCorrelatorImpl) correlate(guildID snowflake.ID) {
+	c.mu.Lock()
+	pending := c.pending[guildID]
+	delete(c.pending, guildID)
+	delete(c.timers, guildID)
+	c.mu.Unlock()
+
+	byActionType := map[discord.AuditLogEvent][]*pendingCorrelation{}
+	for _, correlation := range pending {
+		byActionType[correlation.actionType] = append(byActionType[correlation.actionType], correlation)
+	}
+
+	var retry []*pendingCorrelation
+	for actionType, correlations := range byActionType {
+		auditLog, err := c.client.Rest().GetAuditLog(guildID, 0, actionType, 0, c.config.Limit, rest.WithPriority(rest.PriorityLow))
+		if err != nil {
+			c.config.Logger.Errorf("failed to fetch audit log of guild %s for action type %d: %s", guildID, actionType, err)
+			retry = append(retry, correlations...)
+			continue
+		}
+		retry = append(retry, c.match(correlations, auditLog.AuditLogEntries)...)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, correlation := range retry {
+		correlation.attempts++
+		if correlation.attempts >= c.config.MaxAttempts {
+			c.config.Logger.Debugf("no audit log entry found for target %s with action type %d in guild %s", correlation.targetID, correlation.actionType, guildID)
+			continue
+		}
+		c.enqueue(guildID, correlation)
+	}
+}
+
+// match dispatches the correlated Event for each pendingCorrelation with a matching entry & returns the ones without.
+func (c *auditLogCorrelatorImpl) match(correlations []*pendingCorrelation, entries []discord.AuditLogEntry) []*pendingCorrelation {
+	var unmatched []*pendingCorrelation
+	for _, correlation := range correlations {
+		entry, ok := c.findEntry(correlation, entries)
+		if !ok {
+			unmatched = append(unmatched, correlation)
+			continue
+		}
+		c.client.EventManager().DispatchEvent(correlation.event.CorrelatedEvent(entry))
+	}
+	return unmatched
+}
+
+// findEntry returns the unused entry for the pendingCorrelation which was created closest to receiving the Event & marks it as used.
+func (c *auditLogCorrelatorImpl) findEntry(correlation *pendingCorrelation, entries []discord.AuditLogEntry) (discord.AuditLogEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		found    *discord.AuditLogEntry
+		distance time.Duration
+	)
+	for i, entry := range entries {
+		if entry.ActionType != correlation.actionType || entry.TargetID == nil || *entry.TargetID != correlation.targetID {
+			continue
+		}
+		if _, ok := c.used[entry.ID]; ok {
+			continue
+		}
+		d := entry.ID.Time().Sub(correlation.receivedAt)
+		if d < 0 {
+			d = -d
+		}
+		if d > c.config.Window || (found != nil && d >= distance) {
+			continue
+		}
+		found = &entries[i]
+		distance = d
+	}
+	if found == nil {
+		return discord.AuditLogEntry{}, false
+	}
+
+	now := time.Now()
+	for id, usedAt := range c.used {
+		if now.Sub(usedAt) > 2*c.config.Window {
+			delete(c.used, id)
+		}
+	}
+	c.used[found.ID] = now
+	return *found, true
+}
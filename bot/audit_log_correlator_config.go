@@ -0,0 +1,88 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/log"
+)
+
+// DefaultAuditLogCorrelatorConfig returns a new AuditLogCorrelatorConfig with all default values.
+func DefaultAuditLogCorrelatorConfig() *AuditLogCorrelatorConfig {
+	return &AuditLogCorrelatorConfig{
+		Logger:      log.Default(),
+		ActionTypes: []discord.AuditLogEvent{discord.AuditLogEventMemberKick, discord.AuditLogEventMemberBanAdd, discord.AuditLogEventRoleDelete},
+		Delay:       time.Second,
+		Window:      10 * time.Second,
+		MaxAttempts: 3,
+		Limit:       25,
+	}
+}
+
+// AuditLogCorrelatorConfig can be used to configure the AuditLogCorrelator.
+type AuditLogCorrelatorConfig struct {
+	Logger log.Logger
+	// ActionTypes are the discord.AuditLogEvent(s) Event(s) are correlated for.
+	ActionTypes []discord.AuditLogEvent
+	// Delay is how long Event(s) of a guild are collected before the audit log is fetched once for all of them.
+	// Discord creates the audit log entry shortly after the gateway event, so the entry might not exist yet right after receiving the event.
+	Delay time.Duration
+	// Window is the maximum time between receiving the Event & the creation of the audit log entry.
+	Window time.Duration
+	// MaxAttempts is how often the audit log is fetched for an Event before it is given up.
+	MaxAttempts int
+	// Limit is the number of audit log entries fetched per guild & action type.
+	Limit int
+}
+
+// AuditLogCorrelatorConfigOpt is a functional option for configuring an AuditLogCorrelator.
+type AuditLogCorrelatorConfigOpt func(config *AuditLogCorrelatorConfig)
+
+// Apply applies the given AuditLogCorrelatorConfigOpt(s) to the AuditLogCorrelatorConfig.
+func (c *AuditLogCorrelatorConfig) Apply(opts []AuditLogCorrelatorConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithAuditLogCorrelatorLogger overrides the default logger in the AuditLogCorrelatorConfig.
+func WithAuditLogCorrelatorLogger(logger log.Logger) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithAuditLogCorrelatorActionTypes sets the discord.AuditLogEvent(s) Event(s) are correlated for.
+func WithAuditLogCorrelatorActionTypes(actionTypes ...discord.AuditLogEvent) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.ActionTypes = actionTypes
+	}
+}
+
+// WithAuditLogCorrelatorDelay sets how long Event(s) are collected before the audit log is fetched.
+func WithAuditLogCorrelatorDelay(delay time.Duration) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.Delay = delay
+	}
+}
+
+// WithAuditLogCorrelatorWindow sets the maximum time between receiving an Event & the creation of its audit log entry.
+func WithAuditLogCorrelatorWindow(window time.Duration) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.Window = window
+	}
+}
+
+// WithAuditLogCorrelatorMaxAttempts sets how often the audit log is fetched for an Event.
+func WithAuditLogCorrelatorMaxAttempts(maxAttempts int) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.MaxAttempts = maxAttempts
+	}
+}
+
+// WithAuditLogCorrelatorLimit sets the number of audit log entries fetched per guild & action type.
+func WithAuditLogCorrelatorLimit(limit int) AuditLogCorrelatorConfigOpt {
+	return func(config *AuditLogCorrelatorConfig) {
+		config.Limit = limit
+	}
+}
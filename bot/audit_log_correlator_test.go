@@ -0,0 +1,106 @@
+package bot
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCorrelatorClient struct {
+	Client
+	rest         testCorrelatorRest
+	eventManager testCorrelatorEventManager
+}
+
+func (c *testCorrelatorClient) Rest() rest.Rest {
+	return &c.rest
+}
+
+func (c *testCorrelatorClient) EventManager() EventManager {
+	return &c.eventManager
+}
+
+type testCorrelatorRest struct {
+	rest.Rest
+	entries  []discord.AuditLogEntry
+	requests int32
+}
+
+func (r *testCorrelatorRest) GetAuditLog(_ snowflake.ID, _ snowflake.ID, _ discord.AuditLogEvent, _ snowflake.ID, _ int, _ ...rest.RequestOpt) (*discord.AuditLog, error) {
+	atomic.AddInt32(&r.requests, 1)
+	return &discord.AuditLog{AuditLogEntries: r.entries}, nil
+}
+
+type testCorrelatorEventManager struct {
+	EventManager
+	correlator AuditLogCorrelator
+	events     chan Event
+}
+
+// DispatchEvent passes the event back to the correlator like the real EventManager does.
+func (m *testCorrelatorEventManager) DispatchEvent(event Event) {
+	m.correlator.OnEvent(event)
+	m.events <- event
+}
+
+type testCorrelatableEvent struct {
+	Event
+	targetID snowflake.ID
+	entry    *discord.AuditLogEntry
+}
+
+func (e *testCorrelatableEvent) AuditLogTarget() (snowflake.ID, snowflake.ID, discord.AuditLogEvent) {
+	return 1, e.targetID, discord.AuditLogEventMemberKick
+}
+
+func (e *testCorrelatableEvent) CorrelatedEvent(entry discord.AuditLogEntry) Event {
+	return &testCorrelatedEvent{testCorrelatableEvent: &testCorrelatableEvent{targetID: e.targetID, entry: &entry}}
+}
+
+type testCorrelatedEvent struct {
+	*testCorrelatableEvent
+}
+
+func (e *testCorrelatedEvent) CorrelatedAuditLogEntry() discord.AuditLogEntry {
+	return *e.entry
+}
+
+func TestAuditLogCorrelator(t *testing.T) {
+	now := time.Now()
+	kickedID := snowflake.ID(10)
+	leftID := snowflake.ID(11)
+	entryID := snowflake.New(now)
+
+	client := &testCorrelatorClient{eventManager: testCorrelatorEventManager{events: make(chan Event, 2)}}
+	client.rest.entries = []discord.AuditLogEntry{
+		// too old to belong to the kick
+		{ID: snowflake.New(now.Add(-time.Minute)), TargetID: &kickedID, ActionType: discord.AuditLogEventMemberKick},
+		{ID: entryID, TargetID: &kickedID, UserID: 20, ActionType: discord.AuditLogEventMemberKick},
+	}
+
+	correlator := NewAuditLogCorrelator(client, WithAuditLogCorrelatorDelay(10*time.Millisecond), WithAuditLogCorrelatorMaxAttempts(2))
+	defer correlator.Close()
+	client.eventManager.correlator = correlator
+	correlator.OnEvent(&testCorrelatableEvent{targetID: kickedID})
+	correlator.OnEvent(&testCorrelatableEvent{targetID: leftID})
+
+	select {
+	case event := <-client.eventManager.events:
+		correlated := event.(*testCorrelatedEvent)
+		assert.Equal(t, kickedID, correlated.targetID)
+		assert.Equal(t, entryID, correlated.entry.ID)
+		assert.Equal(t, snowflake.ID(20), correlated.entry.UserID)
+	case <-time.After(time.Second):
+		t.Fatal("correlated event was not dispatched")
+	}
+
+	// the member which left on its own is retried once & then given up, the dispatched kick isn't correlated again
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, client.eventManager.events)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&client.rest.requests))
+}
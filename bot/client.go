@@ -9,6 +9,7 @@ import (
 	"github.com/disgoorg/disgo/httpserver"
 	"github.com/disgoorg/disgo/rest"
 	"github.com/disgoorg/disgo/sharding"
+	"github.com/disgoorg/disgo/voice"
 	"github.com/disgoorg/log"
 	"github.com/disgoorg/snowflake/v2"
 )
@@ -76,6 +77,13 @@ type Client interface {
 	// Disconnect sends a discord.MessageDataVoiceStateUpdate to the specific gateway.Gateway and disconnects the bot from this guild.
 	Disconnect(ctx context.Context, guildID snowflake.ID) error
 
+	// UpdateVoiceState sends a gateway.MessageDataVoiceStateUpdate to the specific gateway.Gateway to join, move or leave a voice channel.
+	// A nil channelID disconnects the bot from the guild.
+	UpdateVoiceState(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error
+
+	// VoiceManager returns the voice.Manager used by the Client to open voice.Conn(s).
+	VoiceManager() voice.Manager
+
 	// RequestMembers sends a discord.MessageDataRequestGuildMembers to the specific gateway.Gateway and requests the Member(s) of the specified guild.
 	//  guildID  : is the snowflake of the guild to request the members of.
 	//  presence : Whether to include discord.Presence data.
@@ -128,6 +136,10 @@ type clientImpl struct {
 	caches cache.Caches
 
 	memberChunkingManager MemberChunkingManager
+
+	auditLogCorrelator AuditLogCorrelator
+
+	voiceManager voice.Manager
 }
 
 func (c *clientImpl) Logger() log.Logger {
@@ -135,6 +147,12 @@ func (c *clientImpl) Logger() log.Logger {
 }
 
 func (c *clientImpl) Close(ctx context.Context) {
+	if c.auditLogCorrelator != nil {
+		c.auditLogCorrelator.Close()
+	}
+	if c.voiceManager != nil {
+		c.voiceManager.Close(ctx)
+	}
 	if c.restServices != nil {
 		c.restServices.Close(ctx)
 	}
@@ -147,6 +165,9 @@ func (c *clientImpl) Close(ctx context.Context) {
 	if c.httpServer != nil {
 		c.httpServer.Close(ctx)
 	}
+	if closer, ok := c.caches.(interface{ Close() }); ok {
+		closer.Close()
+	}
 }
 
 func (c *clientImpl) Token() string {
@@ -228,27 +249,30 @@ func (c *clientImpl) Shard(guildID snowflake.ID) (gateway.Gateway, error) {
 }
 
 func (c *clientImpl) Connect(ctx context.Context, guildID snowflake.ID, channelID snowflake.ID) error {
-	shard, err := c.Shard(guildID)
-	if err != nil {
-		return err
-	}
-	return shard.Send(ctx, gateway.OpcodeVoiceStateUpdate, gateway.MessageDataVoiceStateUpdate{
-		GuildID:   guildID,
-		ChannelID: &channelID,
-	})
+	return c.UpdateVoiceState(ctx, guildID, &channelID, false, false)
 }
 
 func (c *clientImpl) Disconnect(ctx context.Context, guildID snowflake.ID) error {
+	return c.UpdateVoiceState(ctx, guildID, nil, false, false)
+}
+
+func (c *clientImpl) UpdateVoiceState(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error {
 	shard, err := c.Shard(guildID)
 	if err != nil {
 		return err
 	}
 	return shard.Send(ctx, gateway.OpcodeVoiceStateUpdate, gateway.MessageDataVoiceStateUpdate{
 		GuildID:   guildID,
-		ChannelID: nil,
+		ChannelID: channelID,
+		SelfMute:  selfMute,
+		SelfDeaf:  selfDeaf,
 	})
 }
 
+func (c *clientImpl) VoiceManager() voice.Manager {
+	return c.voiceManager
+}
+
 func (c *clientImpl) RequestMembers(ctx context.Context, guildID snowflake.ID, presence bool, nonce string, userIDs ...snowflake.ID) error {
 	shard, err := c.Shard(guildID)
 	if err != nil {
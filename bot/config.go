@@ -8,8 +8,11 @@ import (
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
 	"github.com/disgoorg/disgo/internal/tokenhelper"
+	"github.com/disgoorg/disgo/metrics"
 	"github.com/disgoorg/disgo/rest"
 	"github.com/disgoorg/disgo/sharding"
+	"github.com/disgoorg/disgo/tracing"
+	"github.com/disgoorg/disgo/voice"
 	"github.com/disgoorg/log"
 )
 
@@ -17,6 +20,8 @@ import (
 func DefaultConfig(gatewayHandlers map[gateway.EventType]GatewayEventHandler, httpHandler HTTPServerEventHandler) *Config {
 	return &Config{
 		Logger:                 log.Default(),
+		Metrics:                metrics.Noop,
+		Tracer:                 tracing.Noop,
 		EventManagerConfigOpts: []EventManagerConfigOpt{WithGatewayHandlers(gatewayHandlers), WithHTTPServerHandler(httpHandler)},
 		MemberChunkingFilter:   MemberChunkingFilterNone,
 	}
@@ -24,7 +29,9 @@ func DefaultConfig(gatewayHandlers map[gateway.EventType]GatewayEventHandler, ht
 
 // Config lets you configure your Client instance.
 type Config struct {
-	Logger log.Logger
+	Logger  log.Logger
+	Metrics metrics.Metrics
+	Tracer  tracing.Tracer
 
 	RestClient           rest.Client
 	RestClientConfigOpts []rest.ConfigOpt
@@ -48,6 +55,12 @@ type Config struct {
 
 	MemberChunkingManager MemberChunkingManager
 	MemberChunkingFilter  MemberChunkingFilter
+
+	AuditLogCorrelator           AuditLogCorrelator
+	AuditLogCorrelatorConfigOpts []AuditLogCorrelatorConfigOpt
+
+	VoiceManager           voice.Manager
+	VoiceManagerConfigOpts []voice.ManagerConfigOpt
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Client.
@@ -67,6 +80,21 @@ func WithLogger(logger log.Logger) ConfigOpt {
 	}
 }
 
+// WithMetrics lets you inject a metrics.Metrics which is used by the default rest.Client, EventManager, gateway.Gateway & sharding.ShardManager.
+func WithMetrics(m metrics.Metrics) ConfigOpt {
+	return func(config *Config) {
+		config.Metrics = m
+	}
+}
+
+// WithTracer lets you inject a tracing.Tracer which is used by the default rest.Client & EventManager.
+// Spans of REST requests made with the context.Context of an event are children of the span of the event.
+func WithTracer(tracer tracing.Tracer) ConfigOpt {
+	return func(config *Config) {
+		config.Tracer = tracer
+	}
+}
+
 // WithRestClient lets you inject your own rest.Client.
 func WithRestClient(restClient rest.Client) ConfigOpt {
 	return func(config *Config) {
@@ -204,6 +232,41 @@ func WithMemberChunkingFilter(memberChunkingFilter MemberChunkingFilter) ConfigO
 	}
 }
 
+// WithAuditLogCorrelator lets you inject your own AuditLogCorrelator. It is added as EventListener to the EventManager.
+func WithAuditLogCorrelator(auditLogCorrelator AuditLogCorrelator) ConfigOpt {
+	return func(config *Config) {
+		config.AuditLogCorrelator = auditLogCorrelator
+	}
+}
+
+// WithDefaultAuditLogCorrelator creates an AuditLogCorrelator with sensible defaults.
+func WithDefaultAuditLogCorrelator() ConfigOpt {
+	return func(config *Config) {
+		config.AuditLogCorrelatorConfigOpts = append(config.AuditLogCorrelatorConfigOpts, func(_ *AuditLogCorrelatorConfig) {})
+	}
+}
+
+// WithAuditLogCorrelatorConfigOpts lets you configure the default AuditLogCorrelator.
+func WithAuditLogCorrelatorConfigOpts(opts ...AuditLogCorrelatorConfigOpt) ConfigOpt {
+	return func(config *Config) {
+		config.AuditLogCorrelatorConfigOpts = append(config.AuditLogCorrelatorConfigOpts, opts...)
+	}
+}
+
+// WithVoiceManager lets you inject your own voice.Manager.
+func WithVoiceManager(voiceManager voice.Manager) ConfigOpt {
+	return func(config *Config) {
+		config.VoiceManager = voiceManager
+	}
+}
+
+// WithVoiceManagerConfigOpts lets you configure the default voice.Manager.
+func WithVoiceManagerConfigOpts(opts ...voice.ManagerConfigOpt) ConfigOpt {
+	return func(config *Config) {
+		config.VoiceManagerConfigOpts = append(config.VoiceManagerConfigOpts, opts...)
+	}
+}
+
 // BuildClient creates a new Client instance with the given token, Config, gateway handlers, http handlers os, name, github & version.
 func BuildClient(token string, config Config, gatewayEventHandlerFunc func(client Client) gateway.EventHandlerFunc, httpServerEventHandlerFunc func(client Client) httpserver.EventHandlerFunc, os string, name string, github string, version string) (Client, error) {
 	if token == "" {
@@ -225,6 +288,8 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 		config.RestClientConfigOpts = append([]rest.ConfigOpt{
 			rest.WithUserAgent(fmt.Sprintf("DiscordBot (%s, %s)", github, version)),
 			rest.WithLogger(client.logger),
+			rest.WithMetrics(config.Metrics),
+			rest.WithTracer(config.Tracer),
 			func(config *rest.Config) {
 				config.RateRateLimiterConfigOpts = append([]rest.RateLimiterConfigOpt{rest.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
 			},
@@ -239,10 +304,23 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 	client.restServices = config.Rest
 
 	if config.EventManager == nil {
+		config.EventManagerConfigOpts = append([]EventManagerConfigOpt{WithEventManagerMetrics(config.Metrics), WithEventManagerTracer(config.Tracer)}, config.EventManagerConfigOpts...)
 		config.EventManager = NewEventManager(client, config.EventManagerConfigOpts...)
 	}
 	client.eventManager = config.EventManager
 
+	if config.AuditLogCorrelator == nil && len(config.AuditLogCorrelatorConfigOpts) > 0 {
+		config.AuditLogCorrelatorConfigOpts = append([]AuditLogCorrelatorConfigOpt{
+			WithAuditLogCorrelatorLogger(client.logger),
+		}, config.AuditLogCorrelatorConfigOpts...)
+
+		config.AuditLogCorrelator = NewAuditLogCorrelator(client, config.AuditLogCorrelatorConfigOpts...)
+	}
+	if config.AuditLogCorrelator != nil {
+		client.eventManager.AddEventListeners(config.AuditLogCorrelator)
+	}
+	client.auditLogCorrelator = config.AuditLogCorrelator
+
 	if config.Gateway == nil && len(config.GatewayConfigOpts) > 0 {
 		var gatewayRs *discord.Gateway
 		gatewayRs, err = client.restServices.GetGateway()
@@ -256,6 +334,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 			gateway.WithOS(os),
 			gateway.WithBrowser(name),
 			gateway.WithDevice(name),
+			gateway.WithMetrics(config.Metrics),
 			func(config *gateway.Config) {
 				config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
 			},
@@ -273,7 +352,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 		}
 
 		shardIDs := make([]int, gatewayBotRs.Shards)
-		for i := 0; i < gatewayBotRs.Shards-1; i++ {
+		for i := 0; i < gatewayBotRs.Shards; i++ {
 			shardIDs[i] = i
 		}
 
@@ -286,6 +365,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 				gateway.WithOS(os),
 				gateway.WithBrowser(name),
 				gateway.WithDevice(name),
+				gateway.WithMetrics(config.Metrics),
 				func(config *gateway.Config) {
 					config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
 				},
@@ -319,5 +399,14 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 	}
 	client.caches = config.Caches
 
+	if config.VoiceManager == nil {
+		config.VoiceManagerConfigOpts = append([]voice.ManagerConfigOpt{
+			voice.WithLogger(client.logger),
+		}, config.VoiceManagerConfigOpts...)
+
+		config.VoiceManager = voice.NewManager(client.UpdateVoiceState, client.applicationID, config.VoiceManagerConfigOpts...)
+	}
+	client.voiceManager = config.VoiceManager
+
 	return client, nil
 }
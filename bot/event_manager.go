@@ -1,11 +1,15 @@
 package bot
 
 import (
+	"context"
+	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
+	"github.com/disgoorg/disgo/tracing"
 )
 
 var _ EventManager = (*eventManagerImpl)(nil)
@@ -29,10 +33,10 @@ type EventManager interface {
 	// RemoveEventListeners removes one or more EventListener(s) from the EventManager
 	RemoveEventListeners(eventListeners ...EventListener)
 
-	// HandleGatewayEvent calls the correct GatewayEventHandler for the payload
+	// HandleGatewayEvent calls the correct GatewayEventHandler for the payload. It starts a span for the handling which the dispatched Event(s) carry in their context.Context
 	HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData)
 
-	// HandleHTTPEvent calls the HTTPServerEventHandler for the payload
+	// HandleHTTPEvent calls the HTTPServerEventHandler for the payload. It starts a span for the handling which the dispatched Event(s) carry in their context.Context
 	HandleHTTPEvent(respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate)
 
 	// DispatchEvent dispatches a new Event to the Client's EventListener(s)
@@ -80,35 +84,35 @@ type Event interface {
 	SequenceNumber() int
 }
 
-// GatewayEventHandler is used to handle Gateway Event(s)
+// GatewayEventHandler is used to handle Gateway Event(s). The context.Context should be passed to the dispatched Event(s)
 type GatewayEventHandler interface {
 	EventType() gateway.EventType
-	HandleGatewayEvent(client Client, sequenceNumber int, shardID int, event gateway.EventData)
+	HandleGatewayEvent(ctx context.Context, client Client, sequenceNumber int, shardID int, event gateway.EventData)
 }
 
 // NewGatewayEventHandler returns a new GatewayEventHandler for the given GatewayEventType and handler func
-func NewGatewayEventHandler[T gateway.EventData](eventType gateway.EventType, handleFunc func(client Client, sequenceNumber int, shardID int, event T)) GatewayEventHandler {
+func NewGatewayEventHandler[T gateway.EventData](eventType gateway.EventType, handleFunc func(ctx context.Context, client Client, sequenceNumber int, shardID int, event T)) GatewayEventHandler {
 	return &genericGatewayEventHandler[T]{eventType: eventType, handleFunc: handleFunc}
 }
 
 type genericGatewayEventHandler[T gateway.EventData] struct {
 	eventType  gateway.EventType
-	handleFunc func(client Client, sequenceNumber int, shardID int, event T)
+	handleFunc func(ctx context.Context, client Client, sequenceNumber int, shardID int, event T)
 }
 
 func (h *genericGatewayEventHandler[T]) EventType() gateway.EventType {
 	return h.eventType
 }
 
-func (h *genericGatewayEventHandler[T]) HandleGatewayEvent(client Client, sequenceNumber int, shardID int, event gateway.EventData) {
+func (h *genericGatewayEventHandler[T]) HandleGatewayEvent(ctx context.Context, client Client, sequenceNumber int, shardID int, event gateway.EventData) {
 	if e, ok := event.(T); ok {
-		h.handleFunc(client, sequenceNumber, shardID, e)
+		h.handleFunc(ctx, client, sequenceNumber, shardID, e)
 	}
 }
 
-// HTTPServerEventHandler is used to handle HTTP Event(s)
+// HTTPServerEventHandler is used to handle HTTP Event(s). The context.Context should be passed to the dispatched Event(s)
 type HTTPServerEventHandler interface {
-	HandleHTTPEvent(client Client, respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate)
+	HandleHTTPEvent(ctx context.Context, client Client, respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate)
 }
 
 type eventManagerImpl struct {
@@ -123,7 +127,13 @@ func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if handler, ok := e.config.GatewayHandlers[gatewayEventType]; ok {
-		handler.HandleGatewayEvent(e.client, sequenceNumber, shardID, event)
+		ctx, span := e.startSpan("gateway event "+string(gatewayEventType),
+			tracing.String("disgo.event_type", string(gatewayEventType)),
+			tracing.Int("disgo.shard_id", shardID),
+			tracing.Int("disgo.sequence", sequenceNumber),
+		)
+		defer span.handled()
+		handler.HandleGatewayEvent(ctx, e.client, sequenceNumber, shardID, event)
 	} else {
 		e.config.Logger.Warnf("no handler for gateway event '%s' found", gatewayEventType)
 	}
@@ -132,7 +142,11 @@ func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType
 func (e *eventManagerImpl) HandleHTTPEvent(respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
-	e.config.HTTPServerHandler.HandleHTTPEvent(e.client, respondFunc, event)
+	ctx, span := e.startSpan("http event "+string(gateway.EventTypeInteractionCreate),
+		tracing.String("disgo.event_type", string(gateway.EventTypeInteractionCreate)),
+	)
+	defer span.handled()
+	e.config.HTTPServerHandler.HandleHTTPEvent(ctx, e.client, respondFunc, event)
 }
 
 func (e *eventManagerImpl) DispatchEvent(event Event) {
@@ -144,23 +158,93 @@ func (e *eventManagerImpl) DispatchEvent(event Event) {
 	}()
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
+	eventType := fmt.Sprintf("%T", event)
+	span := eventSpanOf(event)
 	for i := range e.config.EventListeners {
 		if e.config.AsyncEventsEnabled {
+			tracked := span != nil && span.add()
 			go func() {
 				defer func() {
+					if tracked {
+						span.done()
+					}
 					if r := recover(); r != nil {
 						e.config.Logger.Errorf("recovered from panic in event listener: %+v\nstack: %s", r, string(debug.Stack()))
 						return
 					}
 				}()
-				e.config.EventListeners[i].OnEvent(event)
+				e.callListener(e.config.EventListeners[i], eventType, event)
 			}()
 			continue
 		}
-		e.config.EventListeners[i].OnEvent(event)
+		e.callListener(e.config.EventListeners[i], eventType, event)
+	}
+}
+
+// startSpan starts the span of a handled event. It is put into the returned context.Context, so DispatchEvent can keep it open until all async EventListener(s) returned.
+func (e *eventManagerImpl) startSpan(name string, attributes ...tracing.Attribute) (context.Context, *eventSpan) {
+	ctx, span := e.config.Tracer.Start(context.Background(), name, attributes...)
+	es := &eventSpan{span: span}
+	return context.WithValue(ctx, eventSpanKey{}, es), es
+}
+
+type eventSpanKey struct{}
+
+// eventSpanOf returns the eventSpan in the context.Context of the Event or nil if it has none.
+func eventSpanOf(event Event) *eventSpan {
+	e, ok := event.(interface{ Ctx() context.Context })
+	if !ok {
+		return nil
+	}
+	span, _ := e.Ctx().Value(eventSpanKey{}).(*eventSpan)
+	return span
+}
+
+// eventSpan ends the span of a handled event once the handler & all async EventListener(s) of the dispatched Event(s) returned.
+type eventSpan struct {
+	mu        sync.Mutex
+	span      tracing.Span
+	pending   int
+	isHandled bool
+}
+
+// add tracks an async EventListener call. It returns false if the span already ended, e.g. for events dispatched later with the same context.Context.
+func (s *eventSpan) add() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.isHandled && s.pending == 0 {
+		return false
+	}
+	s.pending++
+	return true
+}
+
+// done marks an async EventListener call tracked with add as returned.
+func (s *eventSpan) done() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending--
+	if s.isHandled && s.pending == 0 {
+		s.span.End()
 	}
 }
 
+// handled marks the handler of the event as returned.
+func (s *eventSpan) handled() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.isHandled = true
+	if s.pending == 0 {
+		s.span.End()
+	}
+}
+
+func (e *eventManagerImpl) callListener(listener EventListener, eventType string, event Event) {
+	start := time.Now()
+	listener.OnEvent(event)
+	e.config.Metrics.EventListener(eventType, time.Since(start))
+}
+
 func (e *eventManagerImpl) AddEventListeners(listeners ...EventListener) {
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
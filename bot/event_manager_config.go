@@ -2,13 +2,17 @@ package bot
 
 import (
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/metrics"
+	"github.com/disgoorg/disgo/tracing"
 	"github.com/disgoorg/log"
 )
 
 // DefaultEventManagerConfig returns a new EventManagerConfig with all default values.
 func DefaultEventManagerConfig() *EventManagerConfig {
 	return &EventManagerConfig{
-		Logger: log.Default(),
+		Logger:  log.Default(),
+		Metrics: metrics.Noop,
+		Tracer:  tracing.Noop,
 	}
 }
 
@@ -17,6 +21,8 @@ type EventManagerConfig struct {
 	Logger             log.Logger
 	EventListeners     []EventListener
 	AsyncEventsEnabled bool
+	Metrics            metrics.Metrics
+	Tracer             tracing.Tracer
 
 	GatewayHandlers   map[gateway.EventType]GatewayEventHandler
 	HTTPServerHandler HTTPServerEventHandler
@@ -39,6 +45,20 @@ func WithEventManagerLogger(logger log.Logger) EventManagerConfigOpt {
 	}
 }
 
+// WithEventManagerMetrics sets the metrics.Metrics which records how long the EventListener(s) take to handle each event.
+func WithEventManagerMetrics(m metrics.Metrics) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.Metrics = m
+	}
+}
+
+// WithEventManagerTracer sets the tracing.Tracer which creates a span for each handled gateway & http event.
+func WithEventManagerTracer(tracer tracing.Tracer) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.Tracer = tracer
+	}
+}
+
 // WithListeners adds the given EventListener(s) to the EventManagerConfig.
 func WithListeners(listeners ...EventListener) EventManagerConfigOpt {
 	return func(config *EventManagerConfig) {
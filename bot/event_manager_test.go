@@ -0,0 +1,67 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/tracing"
+)
+
+type testSpan struct {
+	ended chan struct{}
+}
+
+func (s *testSpan) SetAttributes(...tracing.Attribute) {}
+func (s *testSpan) RecordError(error)                  {}
+func (s *testSpan) End()                               { close(s.ended) }
+
+type testTracer struct {
+	span *testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, _ string, _ ...tracing.Attribute) (context.Context, tracing.Span) {
+	return ctx, t.span
+}
+
+type testCtxEvent struct {
+	ctx context.Context
+}
+
+func (e *testCtxEvent) Client() Client       { return nil }
+func (e *testCtxEvent) SequenceNumber() int  { return 0 }
+func (e *testCtxEvent) Ctx() context.Context { return e.ctx }
+
+func TestEventSpanEndsAfterAsyncListeners(t *testing.T) {
+	tracer := &testTracer{span: &testSpan{ended: make(chan struct{})}}
+	release := make(chan struct{})
+
+	var manager EventManager
+	manager = NewEventManager(nil,
+		WithAsyncEventsEnabled(),
+		WithEventManagerTracer(tracer),
+		WithGatewayHandlers(map[gateway.EventType]GatewayEventHandler{
+			gateway.EventTypeRaw: NewGatewayEventHandler(gateway.EventTypeRaw, func(ctx context.Context, _ Client, _ int, _ int, _ gateway.EventRaw) {
+				manager.DispatchEvent(&testCtxEvent{ctx: ctx})
+			}),
+		}),
+		WithListenerFunc(func(e *testCtxEvent) {
+			<-release
+		}),
+	)
+
+	manager.HandleGatewayEvent(gateway.EventTypeRaw, 1, 0, gateway.EventRaw{})
+	select {
+	case <-tracer.span.ended:
+		t.Fatal("span ended before the async listener returned")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-tracer.span.ended:
+	case <-time.After(time.Second):
+		t.Fatal("span did not end after the async listener returned")
+	}
+}
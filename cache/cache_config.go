@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"time"
+
 	"github.com/disgoorg/disgo/discord"
 )
 
@@ -19,6 +21,7 @@ func DefaultConfig() *Config {
 		MessageCachePolicy:             PolicyAll[discord.Message],
 		EmojiCachePolicy:               PolicyAll[discord.Emoji],
 		StickerCachePolicy:             PolicyAll[discord.Sticker],
+		StoreKeyPrefix:                 "disgo",
 	}
 }
 
@@ -38,6 +41,14 @@ type Config struct {
 	MessageCachePolicy             Policy[discord.Message]
 	EmojiCachePolicy               Policy[discord.Emoji]
 	StickerCachePolicy             Policy[discord.Sticker]
+
+	MessageCacheEviction  Eviction[discord.Message]
+	MemberCacheEviction   Eviction[discord.Member]
+	PresenceCacheEviction Eviction[discord.Presence]
+
+	Store             Store
+	StoreKeyPrefix    string
+	StoreErrorHandler StoreErrorHandler
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Caches.
@@ -140,3 +151,67 @@ func WithStickerCachePolicy(policy Policy[discord.Sticker]) ConfigOpt {
 		config.StickerCachePolicy = policy
 	}
 }
+
+// WithMessageCacheEviction sets the Eviction[discord.Message] of the Config. Eviction.MaxSize applies per channel.
+func WithMessageCacheEviction(eviction Eviction[discord.Message]) ConfigOpt {
+	return func(config *Config) {
+		config.MessageCacheEviction = eviction
+	}
+}
+
+// WithMessageCacheMaxPerChannel sets the max number of messages cached per channel. Once exceeded, the least recently used message is evicted.
+func WithMessageCacheMaxPerChannel(maxPerChannel int) ConfigOpt {
+	return func(config *Config) {
+		config.MessageCacheEviction.MaxSize = maxPerChannel
+	}
+}
+
+// WithMessageCacheTTL sets the duration after which cached messages expire if they were not updated.
+func WithMessageCacheTTL(ttl time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.MessageCacheEviction.TTL = ttl
+	}
+}
+
+// WithMessageCacheEvictionFunc sets the EvictionFunc[discord.Message] which is called for each evicted message.
+func WithMessageCacheEvictionFunc(evictionFunc EvictionFunc[discord.Message]) ConfigOpt {
+	return func(config *Config) {
+		config.MessageCacheEviction.OnEvict = evictionFunc
+	}
+}
+
+// WithMemberCacheEviction sets the Eviction[discord.Member] of the Config. Eviction.MaxSize applies per guild.
+func WithMemberCacheEviction(eviction Eviction[discord.Member]) ConfigOpt {
+	return func(config *Config) {
+		config.MemberCacheEviction = eviction
+	}
+}
+
+// WithPresenceCacheEviction sets the Eviction[discord.Presence] of the Config. Eviction.MaxSize applies per guild.
+func WithPresenceCacheEviction(eviction Eviction[discord.Presence]) ConfigOpt {
+	return func(config *Config) {
+		config.PresenceCacheEviction = eviction
+	}
+}
+
+// WithStore sets the Store of the Config. If set, all caches serialize their entities into the Store instead of keeping them in memory.
+// Evictions are not applied to Store backed caches, use the expiry features of the Store instead.
+func WithStore(store Store) ConfigOpt {
+	return func(config *Config) {
+		config.Store = store
+	}
+}
+
+// WithStoreKeyPrefix sets the prefix of all keys written to the Store of the Config. Defaults to "disgo".
+func WithStoreKeyPrefix(keyPrefix string) ConfigOpt {
+	return func(config *Config) {
+		config.StoreKeyPrefix = keyPrefix
+	}
+}
+
+// WithStoreErrorHandler sets the StoreErrorHandler of the Config which is called for errors returned by the Store.
+func WithStoreErrorHandler(errorHandler StoreErrorHandler) ConfigOpt {
+	return func(config *Config) {
+		config.StoreErrorHandler = errorHandler
+	}
+}
@@ -0,0 +1,579 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultJanitorInterval is the interval in which expired entities are removed if Eviction.TTL is set and no Eviction.JanitorInterval is configured.
+// If the TTL is shorter than this interval, the TTL is used instead.
+const DefaultJanitorInterval = time.Minute
+
+// EvictionReason describes why an entity was evicted from a cache.
+type EvictionReason int
+
+const (
+	// EvictionReasonSize means the entity was the least recently used one and the cache exceeded its max size.
+	EvictionReasonSize EvictionReason = iota
+	// EvictionReasonExpired means the entity was not updated within the configured TTL.
+	EvictionReasonExpired
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionReasonSize:
+		return "size"
+	case EvictionReasonExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// EvictionFunc is called for each entity evicted from a cache. For non-grouped caches the groupID is always 0.
+// It is called after the cache was unlocked, so it's safe to access the cache from within.
+type EvictionFunc[T any] func(groupID snowflake.ID, id snowflake.ID, entity T, reason EvictionReason)
+
+// Eviction configures when entities are evicted from a cache. The zero value never evicts anything.
+type Eviction[T any] struct {
+	// MaxSize is the max number of entities kept in a Cache or in each group of a GroupedCache.
+	// Once exceeded, the least recently used entity is evicted. 0 means unlimited.
+	MaxSize int
+
+	// TTL is the duration after which an entity expires if it was not put again. 0 means entities never expire.
+	TTL time.Duration
+
+	// JanitorInterval is the interval in which the background janitor removes expired entities. Defaults to DefaultJanitorInterval.
+	JanitorInterval time.Duration
+
+	// OnEvict is called for each evicted entity. Entities removed explicitly via Remove, RemoveAll or RemoveIf are not reported.
+	OnEvict EvictionFunc[T]
+}
+
+// Enabled returns whether the Eviction evicts anything at all.
+func (e Eviction[T]) Enabled() bool {
+	return e.MaxSize > 0 || e.TTL > 0
+}
+
+func (e Eviction[T]) janitorInterval() time.Duration {
+	if e.JanitorInterval > 0 {
+		return e.JanitorInterval
+	}
+	if e.TTL < DefaultJanitorInterval {
+		return e.TTL
+	}
+	return DefaultJanitorInterval
+}
+
+var _ Cache[any] = (*evictingCache[any])(nil)
+
+// NewEvictingCache returns a new thread safe in-memory Cache which evicts entities as configured by the given Eviction.
+// The Cache runs a background janitor if a TTL is configured, which is stopped by calling Close on the returned Cache.
+func NewEvictingCache[T any](flags Flags, neededFlags Flags, policy Policy[T], eviction Eviction[T]) Cache[T] {
+	return &evictingCache[T]{
+		cache: newEvictingGroupedCache[T](flags, neededFlags, policy, eviction),
+	}
+}
+
+// evictingCache is a Cache stored as the single group 0 of an evictingGroupedCache.
+type evictingCache[T any] struct {
+	cache *evictingGroupedCache[T]
+}
+
+func (c *evictingCache[T]) Get(id snowflake.ID) (T, bool) {
+	return c.cache.Get(0, id)
+}
+
+func (c *evictingCache[T]) Put(id snowflake.ID, entity T) {
+	c.cache.Put(0, id, entity)
+}
+
+func (c *evictingCache[T]) Remove(id snowflake.ID) (T, bool) {
+	return c.cache.Remove(0, id)
+}
+
+func (c *evictingCache[T]) RemoveIf(filterFunc FilterFunc[T]) {
+	c.cache.RemoveIf(func(_ snowflake.ID, entity T) bool {
+		return filterFunc(entity)
+	})
+}
+
+func (c *evictingCache[T]) Len() int {
+	return c.cache.Len()
+}
+
+func (c *evictingCache[T]) All() []T {
+	return c.cache.GroupAll(0)
+}
+
+func (c *evictingCache[T]) MapAll() map[snowflake.ID]T {
+	entities := c.cache.MapGroupAll(0)
+	if entities == nil {
+		return map[snowflake.ID]T{}
+	}
+	return entities
+}
+
+func (c *evictingCache[T]) FindFirst(cacheFindFunc FilterFunc[T]) (T, bool) {
+	return c.cache.FindFirst(func(_ snowflake.ID, entity T) bool {
+		return cacheFindFunc(entity)
+	})
+}
+
+func (c *evictingCache[T]) FindAll(cacheFindFunc FilterFunc[T]) []T {
+	return c.cache.FindAll(func(_ snowflake.ID, entity T) bool {
+		return cacheFindFunc(entity)
+	})
+}
+
+func (c *evictingCache[T]) ForEach(forEachFunc func(entity T)) {
+	c.cache.GroupForEach(0, forEachFunc)
+}
+
+// Close stops the background janitor of the Cache.
+func (c *evictingCache[T]) Close() {
+	c.cache.Close()
+}
+
+var _ GroupedCache[any] = (*evictingGroupedCache[any])(nil)
+
+// NewEvictingGroupedCache returns a new thread safe in-memory GroupedCache which evicts entities as configured by the given Eviction.
+// Eviction.MaxSize applies to each group separately, e.g. the max number of messages per channel.
+// The GroupedCache runs a background janitor if a TTL is configured, which is stopped by calling Close on the returned GroupedCache.
+func NewEvictingGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], eviction Eviction[T]) GroupedCache[T] {
+	return newEvictingGroupedCache[T](flags, neededFlags, policy, eviction)
+}
+
+func newEvictingGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], eviction Eviction[T]) *evictingGroupedCache[T] {
+	c := &evictingGroupedCache[T]{
+		flags:       flags,
+		neededFlags: neededFlags,
+		policy:      policy,
+		eviction:    eviction,
+		groups:      make(map[snowflake.ID]*evictionGroup[T]),
+		done:        make(chan struct{}),
+	}
+	if eviction.TTL > 0 {
+		go c.janitor(eviction.janitorInterval())
+	}
+	return c
+}
+
+type evictionEntry[T any] struct {
+	id        snowflake.ID
+	entity    T
+	expiresAt time.Time
+}
+
+type evictedEntry[T any] struct {
+	groupID snowflake.ID
+	id      snowflake.ID
+	entity  T
+	reason  EvictionReason
+}
+
+// evictionGroup keeps the entities of a group ordered from most to least recently used.
+type evictionGroup[T any] struct {
+	order    *list.List
+	elements map[snowflake.ID]*list.Element
+}
+
+type evictingGroupedCache[T any] struct {
+	mu          sync.RWMutex
+	flags       Flags
+	neededFlags Flags
+	policy      Policy[T]
+	eviction    Eviction[T]
+	groups      map[snowflake.ID]*evictionGroup[T]
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *evictingGroupedCache[T]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case now := <-ticker.C:
+			c.mu.Lock()
+			var evicted []evictedEntry[T]
+			for groupID, group := range c.groups {
+				for element := group.order.Front(); element != nil; {
+					next := element.Next()
+					if entry := element.Value.(*evictionEntry[T]); c.expired(entry, now) {
+						evicted = append(evicted, c.evict(groupID, group, element, EvictionReasonExpired))
+					}
+					element = next
+				}
+			}
+			c.mu.Unlock()
+			c.notify(evicted)
+		}
+	}
+}
+
+// Close stops the background janitor of the GroupedCache.
+func (c *evictingGroupedCache[T]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+func (c *evictingGroupedCache[T]) expired(entry *evictionEntry[T], now time.Time) bool {
+	return c.eviction.TTL > 0 && !now.Before(entry.expiresAt)
+}
+
+// removeElement removes the given element from the group and deletes the group once empty. The caller must hold the lock.
+func (c *evictingGroupedCache[T]) removeElement(groupID snowflake.ID, group *evictionGroup[T], element *list.Element) *evictionEntry[T] {
+	entry := group.order.Remove(element).(*evictionEntry[T])
+	delete(group.elements, entry.id)
+	if group.order.Len() == 0 {
+		delete(c.groups, groupID)
+	}
+	return entry
+}
+
+// evict removes the given element like removeElement and returns it as evictedEntry. The caller must hold the lock.
+func (c *evictingGroupedCache[T]) evict(groupID snowflake.ID, group *evictionGroup[T], element *list.Element, reason EvictionReason) evictedEntry[T] {
+	entry := c.removeElement(groupID, group, element)
+	return evictedEntry[T]{groupID: groupID, id: entry.id, entity: entry.entity, reason: reason}
+}
+
+func (c *evictingGroupedCache[T]) notify(evicted []evictedEntry[T]) {
+	if c.eviction.OnEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.eviction.OnEvict(e.groupID, e.id, e.entity, e.reason)
+	}
+}
+
+// forEach calls the given function for each non-expired entity of the given group from most to least recently used until it returns false.
+// The caller must hold the lock.
+func (c *evictingGroupedCache[T]) forEach(group *evictionGroup[T], now time.Time, forEachFunc func(entry *evictionEntry[T]) bool) bool {
+	for element := group.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*evictionEntry[T])
+		if c.expired(entry, now) {
+			continue
+		}
+		if !forEachFunc(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *evictingGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.mu.Lock()
+	group, ok := c.groups[groupID]
+	if !ok {
+		c.mu.Unlock()
+		var entity T
+		return entity, false
+	}
+	element, ok := group.elements[id]
+	if !ok {
+		c.mu.Unlock()
+		var entity T
+		return entity, false
+	}
+	entry := element.Value.(*evictionEntry[T])
+	if c.expired(entry, time.Now()) {
+		evicted := c.evict(groupID, group, element, EvictionReasonExpired)
+		c.mu.Unlock()
+		c.notify([]evictedEntry[T]{evicted})
+		var entity T
+		return entity, false
+	}
+	group.order.MoveToFront(element)
+	c.mu.Unlock()
+	return entry.entity, true
+}
+
+func (c *evictingGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if c.flags.Missing(c.neededFlags) {
+		return
+	}
+	if c.policy != nil && !c.policy(entity) {
+		return
+	}
+
+	var expiresAt time.Time
+	if c.eviction.TTL > 0 {
+		expiresAt = time.Now().Add(c.eviction.TTL)
+	}
+
+	c.mu.Lock()
+	group, ok := c.groups[groupID]
+	if !ok {
+		group = &evictionGroup[T]{
+			order:    list.New(),
+			elements: make(map[snowflake.ID]*list.Element),
+		}
+		c.groups[groupID] = group
+	}
+
+	if element, ok := group.elements[id]; ok {
+		entry := element.Value.(*evictionEntry[T])
+		entry.entity = entity
+		entry.expiresAt = expiresAt
+		group.order.MoveToFront(element)
+		c.mu.Unlock()
+		return
+	}
+	group.elements[id] = group.order.PushFront(&evictionEntry[T]{
+		id:        id,
+		entity:    entity,
+		expiresAt: expiresAt,
+	})
+
+	var evicted []evictedEntry[T]
+	if c.eviction.MaxSize > 0 {
+		for group.order.Len() > c.eviction.MaxSize {
+			evicted = append(evicted, c.evict(groupID, group, group.order.Back(), EvictionReasonSize))
+		}
+	}
+	c.mu.Unlock()
+	c.notify(evicted)
+}
+
+func (c *evictingGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if group, ok := c.groups[groupID]; ok {
+		if element, ok := group.elements[id]; ok {
+			return c.removeElement(groupID, group, element).entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *evictingGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.groups, groupID)
+}
+
+func (c *evictingGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for groupID, group := range c.groups {
+		for element := group.order.Front(); element != nil; {
+			next := element.Next()
+			if filterFunc(groupID, element.Value.(*evictionEntry[T]).entity) {
+				c.removeElement(groupID, group, element)
+			}
+			element = next
+		}
+	}
+}
+
+func (c *evictingGroupedCache[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	var totalLen int
+	for _, group := range c.groups {
+		totalLen += c.groupLen(group, now)
+	}
+	return totalLen
+}
+
+func (c *evictingGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if group, ok := c.groups[groupID]; ok {
+		return c.groupLen(group, time.Now())
+	}
+	return 0
+}
+
+// groupLen returns the number of entities in the group which are not expired yet. The caller must hold the lock.
+func (c *evictingGroupedCache[T]) groupLen(group *evictionGroup[T], now time.Time) int {
+	if c.eviction.TTL <= 0 {
+		return group.order.Len()
+	}
+	var groupLen int
+	c.forEach(group, now, func(*evictionEntry[T]) bool {
+		groupLen++
+		return true
+	})
+	return groupLen
+}
+
+func (c *evictingGroupedCache[T]) All() map[snowflake.ID][]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	all := make(map[snowflake.ID][]T, len(c.groups))
+	for groupID, group := range c.groups {
+		entities := make([]T, 0, group.order.Len())
+		c.forEach(group, now, func(entry *evictionEntry[T]) bool {
+			entities = append(entities, entry.entity)
+			return true
+		})
+		all[groupID] = entities
+	}
+	return all
+}
+
+func (c *evictingGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	group, ok := c.groups[groupID]
+	if !ok {
+		return nil
+	}
+	all := make([]T, 0, group.order.Len())
+	c.forEach(group, time.Now(), func(entry *evictionEntry[T]) bool {
+		all = append(all, entry.entity)
+		return true
+	})
+	return all
+}
+
+func (c *evictingGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	all := make(map[snowflake.ID]map[snowflake.ID]T, len(c.groups))
+	for groupID, group := range c.groups {
+		entities := make(map[snowflake.ID]T, group.order.Len())
+		c.forEach(group, now, func(entry *evictionEntry[T]) bool {
+			entities[entry.id] = entry.entity
+			return true
+		})
+		all[groupID] = entities
+	}
+	return all
+}
+
+func (c *evictingGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	group, ok := c.groups[groupID]
+	if !ok {
+		return nil
+	}
+	all := make(map[snowflake.ID]T, group.order.Len())
+	c.forEach(group, time.Now(), func(entry *evictionEntry[T]) bool {
+		all[entry.id] = entry.entity
+		return true
+	})
+	return all
+}
+
+func (c *evictingGroupedCache[T]) FindFirst(cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	for groupID, group := range c.groups {
+		if entity, ok := c.groupFindFirst(groupID, group, now, cacheFindFunc); ok {
+			return entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *evictingGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if group, ok := c.groups[groupID]; ok {
+		return c.groupFindFirst(groupID, group, time.Now(), cacheFindFunc)
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *evictingGroupedCache[T]) groupFindFirst(groupID snowflake.ID, group *evictionGroup[T], now time.Time, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+	c.forEach(group, now, func(entry *evictionEntry[T]) bool {
+		if cacheFindFunc(groupID, entry.entity) {
+			found = entry.entity
+			ok = true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+func (c *evictingGroupedCache[T]) FindAll(cacheFindFunc GroupedFilterFunc[T]) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	all := make([]T, 0)
+	for groupID, group := range c.groups {
+		all = c.groupFindAll(all, groupID, group, now, cacheFindFunc)
+	}
+	return all
+}
+
+func (c *evictingGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	all := make([]T, 0)
+	if group, ok := c.groups[groupID]; ok {
+		all = c.groupFindAll(all, groupID, group, time.Now(), cacheFindFunc)
+	}
+	return all
+}
+
+func (c *evictingGroupedCache[T]) groupFindAll(all []T, groupID snowflake.ID, group *evictionGroup[T], now time.Time, cacheFindFunc GroupedFilterFunc[T]) []T {
+	c.forEach(group, now, func(entry *evictionEntry[T]) bool {
+		if cacheFindFunc(groupID, entry.entity) {
+			all = append(all, entry.entity)
+		}
+		return true
+	})
+	return all
+}
+
+func (c *evictingGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	for groupID, group := range c.groups {
+		c.forEach(group, now, func(entry *evictionEntry[T]) bool {
+			forEachFunc(groupID, entry.entity)
+			return true
+		})
+	}
+}
+
+func (c *evictingGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if group, ok := c.groups[groupID]; ok {
+		c.forEach(group, time.Now(), func(entry *evictionEntry[T]) bool {
+			forEachFunc(entry.entity)
+			return true
+		})
+	}
+}
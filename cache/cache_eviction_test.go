@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvictingGroupedCacheMaxSize(t *testing.T) {
+	var evicted []snowflake.ID
+	c := NewEvictingGroupedCache[string](FlagsAll, FlagMessages, nil, Eviction[string]{
+		MaxSize: 2,
+		OnEvict: func(groupID snowflake.ID, id snowflake.ID, entity string, reason EvictionReason) {
+			assert.Equal(t, EvictionReasonSize, reason)
+			evicted = append(evicted, id)
+		},
+	})
+
+	c.Put(1, 1, "a")
+	c.Put(1, 2, "b")
+	c.Put(2, 3, "c")
+
+	// marks 1 as most recently used, so 2 gets evicted next
+	_, ok := c.Get(1, 1)
+	assert.True(t, ok)
+	c.Put(1, 4, "d")
+
+	assert.Equal(t, []snowflake.ID{2}, evicted)
+	assert.Equal(t, 2, c.GroupLen(1))
+	assert.Equal(t, 1, c.GroupLen(2))
+	assert.Equal(t, []string{"d", "a"}, c.GroupAll(1))
+}
+
+func TestEvictingCacheTTL(t *testing.T) {
+	evicted := make(chan snowflake.ID, 1)
+	c := NewEvictingCache[string](FlagsAll, FlagGuilds, nil, Eviction[string]{
+		TTL:             10 * time.Millisecond,
+		JanitorInterval: 5 * time.Millisecond,
+		OnEvict: func(_ snowflake.ID, id snowflake.ID, _ string, reason EvictionReason) {
+			assert.Equal(t, EvictionReasonExpired, reason)
+			evicted <- id
+		},
+	})
+	defer c.(*evictingCache[string]).Close()
+
+	c.Put(1, "a")
+	_, ok := c.Get(1)
+	assert.True(t, ok)
+
+	select {
+	case id := <-evicted:
+		assert.Equal(t, snowflake.ID(1), id)
+	case <-time.After(time.Second):
+		t.Fatal("entity was not evicted by the janitor")
+	}
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestEvictingGroupedCacheLenSkipsExpired(t *testing.T) {
+	// the janitor doesn't run before the end of the test
+	c := NewEvictingGroupedCache[string](FlagsAll, FlagGuilds, nil, Eviction[string]{
+		TTL:             10 * time.Millisecond,
+		JanitorInterval: time.Hour,
+	})
+	defer c.(*evictingGroupedCache[string]).Close()
+
+	c.Put(1, 1, "a")
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, 1, c.GroupLen(1))
+
+	time.Sleep(20 * time.Millisecond)
+	c.Put(1, 2, "b")
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, 1, c.GroupLen(1))
+	assert.Len(t, c.GroupAll(1), c.GroupLen(1))
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SnapshotVersion is the version of the snapshot format written by Snapshot.
+const SnapshotVersion = 2
+
+// ErrUnsupportedSnapshotVersion is returned by Restore when the snapshot was written in an unknown format.
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported cache snapshot version")
+
+type snapshot struct {
+	Version              int                                                           `json:"version"`
+	Sessions             map[int]gateway.Session                                       `json:"sessions,omitempty"`
+	SelfUser             *discord.OAuth2User                                           `json:"self_user,omitempty"`
+	Guilds               []discord.Guild                                               `json:"guilds"`
+	UnavailableGuilds    []snowflake.ID                                                `json:"unavailable_guilds"`
+	Channels             snapshotChannels                                              `json:"channels"`
+	StageInstances       map[snowflake.ID]map[snowflake.ID]discord.StageInstance       `json:"stage_instances"`
+	GuildScheduledEvents map[snowflake.ID]map[snowflake.ID]discord.GuildScheduledEvent `json:"guild_scheduled_events"`
+	Roles                map[snowflake.ID]map[snowflake.ID]discord.Role                `json:"roles"`
+	Members              map[snowflake.ID]map[snowflake.ID]discord.Member              `json:"members"`
+	ThreadMembers        map[snowflake.ID]map[snowflake.ID]discord.ThreadMember        `json:"thread_members"`
+	Presences            map[snowflake.ID]map[snowflake.ID]discord.Presence            `json:"presences"`
+	VoiceStates          map[snowflake.ID]map[snowflake.ID]discord.VoiceState          `json:"voice_states"`
+	Messages             map[snowflake.ID]map[snowflake.ID]discord.Message             `json:"messages"`
+	Emojis               map[snowflake.ID]map[snowflake.ID]discord.Emoji               `json:"emojis"`
+	Stickers             map[snowflake.ID]map[snowflake.ID]discord.Sticker             `json:"stickers"`
+}
+
+type snapshotChannels []discord.Channel
+
+func (c *snapshotChannels) UnmarshalJSON(data []byte) error {
+	var channels []discord.UnmarshalChannel
+	if err := json.Unmarshal(data, &channels); err != nil {
+		return err
+	}
+	*c = make([]discord.Channel, len(channels))
+	for i := range channels {
+		(*c)[i] = channels[i].Channel
+	}
+	return nil
+}
+
+// Snapshot writes all entities of the given Caches together with the given gateway.Session(s) by shard id to the given io.Writer.
+// Close the gateway(s) with websocket.CloseServiceRestart before taking the snapshot & read the sessions via gateway.Gateway.SessionID, gateway.Gateway.LastSequenceReceived and gateway.Gateway.ResumeGatewayURL,
+// so the sequence of each session matches the cached entities. A session closed with websocket.CloseNormalClosure (1000) ends & can't be resumed.
+func Snapshot(w io.Writer, caches Caches, sessions map[int]gateway.Session) error {
+	s := snapshot{
+		Version:              SnapshotVersion,
+		Sessions:             sessions,
+		Guilds:               caches.Guilds().All(),
+		UnavailableGuilds:    caches.Guilds().UnavailableGuilds(),
+		Channels:             caches.Channels().All(),
+		StageInstances:       caches.StageInstances().MapAll(),
+		GuildScheduledEvents: caches.GuildScheduledEvents().MapAll(),
+		Roles:                caches.Roles().MapAll(),
+		Members:              caches.Members().MapAll(),
+		ThreadMembers:        caches.ThreadMembers().MapAll(),
+		Presences:            caches.Presences().MapAll(),
+		VoiceStates:          caches.VoiceStates().MapAll(),
+		Messages:             caches.Messages().MapAll(),
+		Emojis:               caches.Emojis().MapAll(),
+		Stickers:             caches.Stickers().MapAll(),
+	}
+	if selfUser, ok := caches.GetSelfUser(); ok {
+		s.SelfUser = &selfUser
+	}
+	return json.NewEncoder(w).Encode(s)
+}
+
+// Restore reads a snapshot written by Snapshot and returns new Caches with the given ConfigOpt(s) applied containing all entities of the snapshot
+// together with the gateway.Session(s) by shard id. Put the sessions into the gateway.SessionStore passed to gateway.WithSessionStore to resume them.
+// The restored entities still pass through the configured Flags & Policy(s).
+func Restore(r io.Reader, opts ...ConfigOpt) (Caches, map[int]gateway.Session, error) {
+	var s snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode cache snapshot: %w", err)
+	}
+	if s.Version != SnapshotVersion {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedSnapshotVersion, s.Version)
+	}
+
+	caches := New(opts...)
+	if s.SelfUser != nil {
+		caches.PutSelfUser(*s.SelfUser)
+	}
+	for _, guild := range s.Guilds {
+		caches.Guilds().Put(guild.ID, guild)
+	}
+	for _, guildID := range s.UnavailableGuilds {
+		caches.Guilds().SetUnavailable(guildID)
+	}
+	for _, channel := range s.Channels {
+		caches.Channels().Put(channel.ID(), channel)
+	}
+	restoreGrouped(caches.StageInstances(), s.StageInstances)
+	restoreGrouped(caches.GuildScheduledEvents(), s.GuildScheduledEvents)
+	restoreGrouped(caches.Roles(), s.Roles)
+	restoreGrouped(caches.Members(), s.Members)
+	restoreGrouped(caches.ThreadMembers(), s.ThreadMembers)
+	restoreGrouped(caches.Presences(), s.Presences)
+	restoreGrouped(caches.VoiceStates(), s.VoiceStates)
+	restoreGrouped(caches.Messages(), s.Messages)
+	restoreGrouped(caches.Emojis(), s.Emojis)
+	restoreGrouped(caches.Stickers(), s.Stickers)
+	return caches, s.Sessions, nil
+}
+
+func restoreGrouped[T any](cache GroupedCache[T], entities map[snowflake.ID]map[snowflake.ID]T) {
+	for groupID, groupEntities := range entities {
+		for id, entity := range groupEntities {
+			cache.Put(groupID, id, entity)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	caches := New(WithCacheFlags(FlagsAll))
+	caches.PutSelfUser(discord.OAuth2User{User: discord.User{ID: 1, Username: "bot"}})
+	caches.Guilds().Put(10, discord.Guild{ID: 10, Name: "guild"})
+	caches.Guilds().SetUnavailable(11)
+	caches.Roles().Put(10, 20, discord.Role{ID: 20, Name: "role"})
+	caches.Members().Put(10, 1, discord.Member{GuildID: 10, User: discord.User{ID: 1}})
+
+	var channel discord.UnmarshalChannel
+	err := json.Unmarshal([]byte(`{"id":"30","type":0,"guild_id":"10","name":"general"}`), &channel)
+	assert.NoError(t, err)
+	caches.Channels().Put(30, channel.Channel)
+
+	session := gateway.Session{SessionID: "session", LastSequenceReceived: 42, ResumeGatewayURL: "wss://resume.discord.gg", ShardCount: 1}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, Snapshot(buf, caches, map[int]gateway.Session{0: session}))
+
+	restored, sessions, err := Restore(buf, WithCacheFlags(FlagsAll))
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]gateway.Session{0: session}, sessions)
+
+	selfUser, ok := restored.GetSelfUser()
+	assert.True(t, ok)
+	assert.Equal(t, "bot", selfUser.Username)
+
+	guild, ok := restored.Guilds().Get(10)
+	assert.True(t, ok)
+	assert.Equal(t, "guild", guild.Name)
+	assert.True(t, restored.Guilds().IsUnavailable(11))
+
+	role, ok := restored.Roles().Get(10, 20)
+	assert.True(t, ok)
+	assert.Equal(t, "role", role.Name)
+
+	_, ok = restored.Members().Get(10, 1)
+	assert.True(t, ok)
+
+	textChannel, ok := restored.Channels().GetGuildTextChannel(30)
+	assert.True(t, ok)
+	assert.Equal(t, "general", textChannel.Name())
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+)
+
+// Store is a simple key value store which can be used to back caches by an external service like Redis.
+// Entities are stored serialized, which allows multiple processes to share the same cache state.
+// Implementations must be thread safe.
+type Store interface {
+	// Get returns the value of the given key and a bool whether it was found or not.
+	Get(key string) ([]byte, bool, error)
+
+	// Set stores the given value with the given key. If the key is already present, it will be overwritten.
+	Set(key string, value []byte) error
+
+	// Delete removes the given key. Deleting a key which does not exist is not an error.
+	Delete(key string) error
+
+	// Scan calls the given function for each key starting with the given prefix until it returns false.
+	// The store must not be locked while calling the function as it may modify the store.
+	Scan(prefix string, scanFunc func(key string, value []byte) bool) error
+}
+
+// StoreErrorHandler is called when a Store backed cache encounters an error as the cache interfaces have no way to return errors.
+type StoreErrorHandler func(err error)
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns a new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		values: make(map[string][]byte),
+	}
+}
+
+// MemoryStore is a thread safe in-memory Store implementation.
+// It is mainly useful for testing Store backed caches without running an external service.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	values map[string][]byte
+}
+
+func (s *MemoryStore) Get(key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return append([]byte(nil), value...), true, nil
+}
+
+func (s *MemoryStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = append([]byte(nil), value...)
+	return nil
+}
+
+func (s *MemoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+func (s *MemoryStore) Scan(prefix string, scanFunc func(key string, value []byte) bool) error {
+	s.mu.RLock()
+	values := make(map[string][]byte)
+	for key, value := range s.values {
+		if strings.HasPrefix(key, prefix) {
+			values[key] = append([]byte(nil), value...)
+		}
+	}
+	s.mu.RUnlock()
+
+	for key, value := range values {
+		if !scanFunc(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Len returns the number of keys in the MemoryStore.
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.values)
+}
@@ -77,10 +77,15 @@ type Caches interface {
 }
 
 // New returns a new default Caches instance with the given ConfigOpt(s) applied.
+// If a Store is configured, all caches are backed by the Store.
 func New(opts ...ConfigOpt) Caches {
 	config := DefaultConfig()
 	config.Apply(opts)
 
+	if config.Store != nil {
+		return newStoreCaches(*config)
+	}
+
 	return &cachesImpl{
 		config: *config,
 
@@ -89,16 +94,48 @@ func New(opts ...ConfigOpt) Caches {
 		stageInstanceCache:       NewGroupedCache[discord.StageInstance](config.CacheFlags, FlagStageInstances, config.StageInstanceCachePolicy),
 		guildScheduledEventCache: NewGroupedCache[discord.GuildScheduledEvent](config.CacheFlags, FlagGuildScheduledEvents, config.GuildScheduledEventCachePolicy),
 		roleCache:                NewGroupedCache[discord.Role](config.CacheFlags, FlagRoles, config.RoleCachePolicy),
-		memberCache:              NewGroupedCache[discord.Member](config.CacheFlags, FlagMembers, config.MemberCachePolicy),
+		memberCache:              newGroupedCache[discord.Member](config.CacheFlags, FlagMembers, config.MemberCachePolicy, config.MemberCacheEviction),
 		threadMemberCache:        NewGroupedCache[discord.ThreadMember](config.CacheFlags, FlagThreadMembers, config.ThreadMemberCachePolicy),
-		presenceCache:            NewGroupedCache[discord.Presence](config.CacheFlags, FlagPresences, config.PresenceCachePolicy),
+		presenceCache:            newGroupedCache[discord.Presence](config.CacheFlags, FlagPresences, config.PresenceCachePolicy, config.PresenceCacheEviction),
 		voiceStateCache:          NewGroupedCache[discord.VoiceState](config.CacheFlags, FlagVoiceStates, config.VoiceStateCachePolicy),
-		messageCache:             NewGroupedCache[discord.Message](config.CacheFlags, FlagMessages, config.MessageCachePolicy),
+		messageCache:             newGroupedCache[discord.Message](config.CacheFlags, FlagMessages, config.MessageCachePolicy, config.MessageCacheEviction),
 		emojiCache:               NewGroupedCache[discord.Emoji](config.CacheFlags, FlagEmojis, config.EmojiCachePolicy),
 		stickerCache:             NewGroupedCache[discord.Sticker](config.CacheFlags, FlagStickers, config.StickerCachePolicy),
 	}
 }
 
+// newGroupedCache returns an evicting GroupedCache if the given Eviction is enabled, otherwise the default GroupedCache.
+func newGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], eviction Eviction[T]) GroupedCache[T] {
+	if eviction.Enabled() {
+		return NewEvictingGroupedCache[T](flags, neededFlags, policy, eviction)
+	}
+	return NewGroupedCache[T](flags, neededFlags, policy)
+}
+
+func newStoreCaches(config Config) Caches {
+	var (
+		store        = config.Store
+		prefix       = config.StoreKeyPrefix + ":"
+		errorHandler = config.StoreErrorHandler
+	)
+	return &cachesImpl{
+		config: config,
+
+		guildCache:               NewStoreGuildCache(store, prefix+"guilds", config.CacheFlags, config.GuildCachePolicy, errorHandler),
+		channelCache:             NewStoreChannelCache(store, prefix+"channels", config.CacheFlags, config.ChannelCachePolicy, errorHandler),
+		stageInstanceCache:       NewStoreGroupedCache[discord.StageInstance](store, prefix+"stage_instances", config.CacheFlags, FlagStageInstances, config.StageInstanceCachePolicy, errorHandler),
+		guildScheduledEventCache: NewStoreGroupedCache[discord.GuildScheduledEvent](store, prefix+"guild_scheduled_events", config.CacheFlags, FlagGuildScheduledEvents, config.GuildScheduledEventCachePolicy, errorHandler),
+		roleCache:                NewStoreGroupedCache[discord.Role](store, prefix+"roles", config.CacheFlags, FlagRoles, config.RoleCachePolicy, errorHandler),
+		memberCache:              NewStoreGroupedCache[discord.Member](store, prefix+"members", config.CacheFlags, FlagMembers, config.MemberCachePolicy, errorHandler),
+		threadMemberCache:        NewStoreGroupedCache[discord.ThreadMember](store, prefix+"thread_members", config.CacheFlags, FlagThreadMembers, config.ThreadMemberCachePolicy, errorHandler),
+		presenceCache:            NewStoreGroupedCache[discord.Presence](store, prefix+"presences", config.CacheFlags, FlagPresences, config.PresenceCachePolicy, errorHandler),
+		voiceStateCache:          NewStoreGroupedCache[discord.VoiceState](store, prefix+"voice_states", config.CacheFlags, FlagVoiceStates, config.VoiceStateCachePolicy, errorHandler),
+		messageCache:             NewStoreGroupedCache[discord.Message](store, prefix+"messages", config.CacheFlags, FlagMessages, config.MessageCachePolicy, errorHandler),
+		emojiCache:               NewStoreGroupedCache[discord.Emoji](store, prefix+"emojis", config.CacheFlags, FlagEmojis, config.EmojiCachePolicy, errorHandler),
+		stickerCache:             NewStoreGroupedCache[discord.Sticker](store, prefix+"stickers", config.CacheFlags, FlagStickers, config.StickerCachePolicy, errorHandler),
+	}
+}
+
 type cachesImpl struct {
 	config Config
 
@@ -124,70 +161,38 @@ func (c *cachesImpl) CacheFlags() Flags {
 }
 
 func (c *cachesImpl) GetMemberPermissions(member discord.Member) discord.Permissions {
-	if guild, ok := c.Guilds().Get(member.GuildID); ok && guild.OwnerID == member.User.ID {
-		return discord.PermissionsAll
-	}
-
-	var permissions discord.Permissions
-	if publicRole, ok := c.Roles().Get(member.GuildID, member.GuildID); ok {
-		permissions = publicRole.Permissions
-	}
-
-	for _, role := range c.MemberRoles(member) {
-		permissions = permissions.Add(role.Permissions)
-		if permissions.Has(discord.PermissionAdministrator) {
-			return discord.PermissionsAll
-		}
-	}
-	if member.CommunicationDisabledUntil != nil {
-		permissions &= discord.PermissionViewChannel | discord.PermissionReadMessageHistory
-	}
-	return permissions
+	return memberPermissions(c, nil, member).Permissions()
 }
 
 func (c *cachesImpl) GetMemberPermissionsInChannel(channel discord.GuildChannel, member discord.Member) discord.Permissions {
-	permissions := c.GetMemberPermissions(member)
-	if permissions.Has(discord.PermissionAdministrator) {
-		return discord.PermissionsAll
-	}
-
-	var (
-		allowRaw discord.Permissions
-		denyRaw  discord.Permissions
-	)
-	if overwrite, ok := channel.PermissionOverwrites().Role(channel.GuildID()); ok {
-		allowRaw = overwrite.Allow
-		denyRaw = overwrite.Deny
-	}
+	return memberPermissions(c, channel, member).Permissions()
+}
 
-	var (
-		allowRole discord.Permissions
-		denyRole  discord.Permissions
-	)
-	for _, roleID := range member.RoleIDs {
-		if roleID == channel.GuildID() {
-			continue
-		}
+// ExplainMemberPermissionsInChannel returns the calculated permissions of the given member in the given channel & which role, overwrite or rule allowed or denied each permission.
+// This requires the FlagRoles and FlagChannels to be set. For private threads FlagThreadMembers is also required.
+func ExplainMemberPermissionsInChannel(caches Caches, channel discord.GuildChannel, member discord.Member) discord.PermissionsExplanation {
+	return memberPermissions(caches, channel, member).Explain()
+}
 
-		if overwrite, ok := channel.PermissionOverwrites().Role(roleID); ok {
-			allowRole = allowRole.Add(overwrite.Allow)
-			denyRole = denyRole.Add(overwrite.Deny)
-		}
+func memberPermissions(caches Caches, channel discord.GuildChannel, member discord.Member) discord.MemberPermissions {
+	permissions := discord.MemberPermissions{
+		Member:  member,
+		Roles:   caches.MemberRoles(member),
+		Channel: channel,
 	}
-
-	allowRaw = (allowRaw & (denyRole - 1)) | allowRole
-	denyRaw = (denyRaw & (allowRole - 1)) | denyRole
-
-	if overwrite, ok := channel.PermissionOverwrites().Member(member.User.ID); ok {
-		allowRaw = (allowRaw & (overwrite.Deny - 1)) | overwrite.Allow
-		denyRaw = (denyRaw & (overwrite.Allow - 1)) | overwrite.Deny
+	if guild, ok := caches.Guilds().Get(member.GuildID); ok {
+		permissions.OwnerID = guild.OwnerID
 	}
-
-	permissions &= denyRaw - 1
-	permissions |= allowRaw
-
-	if member.CommunicationDisabledUntil != nil {
-		permissions &= discord.PermissionViewChannel | discord.PermissionReadMessageHistory
+	if publicRole, ok := caches.Roles().Get(member.GuildID, member.GuildID); ok {
+		permissions.Roles = append(permissions.Roles, publicRole)
+	}
+	if thread, ok := channel.(discord.GuildThread); ok {
+		if parentID := thread.ParentID(); parentID != nil {
+			if parent, ok := caches.Channels().Get(*parentID); ok {
+				permissions.Parent, _ = parent.(discord.GuildChannel)
+			}
+		}
+		_, permissions.ThreadMember = caches.ThreadMembers().Get(thread.ID(), member.User.ID)
 	}
 	return permissions
 }
@@ -285,3 +290,12 @@ func (c *cachesImpl) StageInstances() GroupedCache[discord.StageInstance] {
 func (c *cachesImpl) GuildScheduledEvents() GroupedCache[discord.GuildScheduledEvent] {
 	return c.guildScheduledEventCache
 }
+
+// Close stops the background janitors of all evicting caches. bot.Client.Close calls it if the Caches implement it.
+func (c *cachesImpl) Close() {
+	for _, cache := range []any{c.memberCache, c.presenceCache, c.messageCache} {
+		if closer, ok := cache.(interface{ Close() }); ok {
+			closer.Close()
+		}
+	}
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/json"
 	"github.com/disgoorg/snowflake/v2"
 )
 
@@ -69,6 +70,20 @@ func NewChannelCache(flags Flags, policy Policy[discord.Channel]) ChannelCache {
 	}
 }
 
+// NewStoreChannelCache returns a new ChannelCache which stores the channels in the given Store.
+// See NewStoreCache for more information.
+func NewStoreChannelCache(store Store, keyPrefix string, flags Flags, policy Policy[discord.Channel], errorHandler StoreErrorHandler) ChannelCache {
+	return &channelCacheImpl{
+		Cache: newStoreCache[discord.Channel](store, keyPrefix, flags, FlagChannels, policy, errorHandler, unmarshalChannel),
+	}
+}
+
+func unmarshalChannel(data []byte) (discord.Channel, error) {
+	var v discord.UnmarshalChannel
+	err := json.Unmarshal(data, &v)
+	return v.Channel, err
+}
+
 type channelCacheImpl struct {
 	Cache[discord.Channel]
 }
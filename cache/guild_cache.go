@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/disgoorg/disgo/discord"
@@ -131,3 +134,104 @@ func (c *guildCacheImpl) UnavailableGuilds() []snowflake.ID {
 	}
 	return guilds
 }
+
+// NewStoreGuildCache returns a new GuildCache which stores the guilds as well as the unready and unavailable guilds in the given Store.
+// See NewStoreCache for more information.
+func NewStoreGuildCache(store Store, keyPrefix string, flags Flags, policy Policy[discord.Guild], errorHandler StoreErrorHandler) GuildCache {
+	return &storeGuildCacheImpl{
+		Cache:             NewStoreCache[discord.Guild](store, keyPrefix, flags, FlagGuilds, policy, errorHandler),
+		store:             store,
+		unreadyPrefix:     keyPrefix + "_unready:",
+		unavailablePrefix: keyPrefix + "_unavailable:",
+		errorHandler:      errorHandler,
+	}
+}
+
+// storeGuildCacheImpl is a GuildCache implementation backed by a Store.
+type storeGuildCacheImpl struct {
+	Cache[discord.Guild]
+	store             Store
+	unreadyPrefix     string
+	unavailablePrefix string
+	errorHandler      StoreErrorHandler
+}
+
+func (c *storeGuildCacheImpl) handleErr(err error) {
+	if c.errorHandler != nil {
+		c.errorHandler(err)
+	}
+}
+
+func (c *storeGuildCacheImpl) unreadyShardPrefix(shardID int) string {
+	return c.unreadyPrefix + strconv.Itoa(shardID) + ":"
+}
+
+func (c *storeGuildCacheImpl) set(key string) {
+	if err := c.store.Set(key, []byte{}); err != nil {
+		c.handleErr(fmt.Errorf("failed to set cache key %s: %w", key, err))
+	}
+}
+
+func (c *storeGuildCacheImpl) delete(key string) {
+	if err := c.store.Delete(key); err != nil {
+		c.handleErr(fmt.Errorf("failed to delete cache key %s: %w", key, err))
+	}
+}
+
+func (c *storeGuildCacheImpl) exists(key string) bool {
+	_, ok, err := c.store.Get(key)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to get cache key %s: %w", key, err))
+	}
+	return ok
+}
+
+func (c *storeGuildCacheImpl) ids(prefix string) []snowflake.ID {
+	ids := make([]snowflake.ID, 0)
+	err := c.store.Scan(prefix, func(key string, _ []byte) bool {
+		id, err := snowflake.Parse(strings.TrimPrefix(key, prefix))
+		if err != nil {
+			c.handleErr(fmt.Errorf("invalid cache key %s: %w", key, err))
+			return true
+		}
+		ids = append(ids, id)
+		return true
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", prefix, err))
+	}
+	return ids
+}
+
+func (c *storeGuildCacheImpl) SetReady(shardID int, guildID snowflake.ID) {
+	c.delete(c.unreadyShardPrefix(shardID) + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) SetUnready(shardID int, guildID snowflake.ID) {
+	c.set(c.unreadyShardPrefix(shardID) + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) IsUnready(shardID int, guildID snowflake.ID) bool {
+	return c.exists(c.unreadyShardPrefix(shardID) + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) UnreadyGuilds(shardID int) []snowflake.ID {
+	return c.ids(c.unreadyShardPrefix(shardID))
+}
+
+func (c *storeGuildCacheImpl) SetUnavailable(guildID snowflake.ID) {
+	c.Remove(guildID)
+	c.set(c.unavailablePrefix + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) SetAvailable(guildID snowflake.ID) {
+	c.delete(c.unavailablePrefix + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) IsUnavailable(guildID snowflake.ID) bool {
+	return c.exists(c.unavailablePrefix + guildID.String())
+}
+
+func (c *storeGuildCacheImpl) UnavailableGuilds() []snowflake.ID {
+	return c.ids(c.unavailablePrefix)
+}
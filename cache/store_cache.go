@@ -0,0 +1,477 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// entityUnmarshalFunc is used to deserialize entities which can't be unmarshalled into T directly, like interfaces.
+type entityUnmarshalFunc[T any] func(data []byte) (T, error)
+
+func unmarshalEntity[T any](data []byte) (T, error) {
+	var entity T
+	err := json.Unmarshal(data, &entity)
+	return entity, err
+}
+
+var _ Cache[any] = (*storeCache[any])(nil)
+
+// NewStoreCache returns a new Cache which serializes the entities as JSON into the given Store.
+// All keys are prefixed with the given keyPrefix followed by a colon. Errors returned by the Store are passed to the errorHandler which may be nil.
+// Other than the DefaultCache, the thread safety of this cache depends on the Store and operations like Remove are not atomic.
+func NewStoreCache[T any](store Store, keyPrefix string, flags Flags, neededFlags Flags, policy Policy[T], errorHandler StoreErrorHandler) Cache[T] {
+	return newStoreCache[T](store, keyPrefix, flags, neededFlags, policy, errorHandler, unmarshalEntity[T])
+}
+
+func newStoreCache[T any](store Store, keyPrefix string, flags Flags, neededFlags Flags, policy Policy[T], errorHandler StoreErrorHandler, unmarshal entityUnmarshalFunc[T]) *storeCache[T] {
+	return &storeCache[T]{
+		store:        store,
+		keyPrefix:    keyPrefix + ":",
+		flags:        flags,
+		neededFlags:  neededFlags,
+		policy:       policy,
+		errorHandler: errorHandler,
+		unmarshal:    unmarshal,
+	}
+}
+
+type storeCache[T any] struct {
+	store        Store
+	keyPrefix    string
+	flags        Flags
+	neededFlags  Flags
+	policy       Policy[T]
+	errorHandler StoreErrorHandler
+	unmarshal    entityUnmarshalFunc[T]
+}
+
+func (c *storeCache[T]) key(id snowflake.ID) string {
+	return c.keyPrefix + id.String()
+}
+
+func (c *storeCache[T]) handleErr(err error) {
+	if c.errorHandler != nil {
+		c.errorHandler(err)
+	}
+}
+
+func (c *storeCache[T]) decode(key string, data []byte) (T, bool) {
+	entity, err := c.unmarshal(data)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to unmarshal cache entity %s: %w", key, err))
+		return entity, false
+	}
+	return entity, true
+}
+
+// scan calls the given function for each decodable entity until it returns false.
+func (c *storeCache[T]) scan(scanFunc func(key string, id snowflake.ID, entity T) bool) {
+	err := c.store.Scan(c.keyPrefix, func(key string, value []byte) bool {
+		id, err := snowflake.Parse(strings.TrimPrefix(key, c.keyPrefix))
+		if err != nil {
+			c.handleErr(fmt.Errorf("invalid cache key %s: %w", key, err))
+			return true
+		}
+		entity, ok := c.decode(key, value)
+		if !ok {
+			return true
+		}
+		return scanFunc(key, id, entity)
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", c.keyPrefix, err))
+	}
+}
+
+func (c *storeCache[T]) Get(id snowflake.ID) (T, bool) {
+	key := c.key(id)
+	data, ok, err := c.store.Get(key)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to get cache entity %s: %w", key, err))
+	}
+	if !ok || err != nil {
+		var entity T
+		return entity, false
+	}
+	return c.decode(key, data)
+}
+
+func (c *storeCache[T]) Put(id snowflake.ID, entity T) {
+	if c.flags.Missing(c.neededFlags) {
+		return
+	}
+	if c.policy != nil && !c.policy(entity) {
+		return
+	}
+	key := c.key(id)
+	data, err := json.Marshal(entity)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to marshal cache entity %s: %w", key, err))
+		return
+	}
+	if err = c.store.Set(key, data); err != nil {
+		c.handleErr(fmt.Errorf("failed to set cache entity %s: %w", key, err))
+	}
+}
+
+func (c *storeCache[T]) Remove(id snowflake.ID) (T, bool) {
+	entity, ok := c.Get(id)
+	if !ok {
+		return entity, false
+	}
+	key := c.key(id)
+	if err := c.store.Delete(key); err != nil {
+		c.handleErr(fmt.Errorf("failed to delete cache entity %s: %w", key, err))
+		return entity, false
+	}
+	return entity, true
+}
+
+func (c *storeCache[T]) RemoveIf(filterFunc FilterFunc[T]) {
+	var keys []string
+	c.scan(func(key string, _ snowflake.ID, entity T) bool {
+		if filterFunc(entity) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	deleteKeys(c.store, keys, c.handleErr)
+}
+
+func (c *storeCache[T]) Len() int {
+	var length int
+	err := c.store.Scan(c.keyPrefix, func(_ string, _ []byte) bool {
+		length++
+		return true
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", c.keyPrefix, err))
+	}
+	return length
+}
+
+func (c *storeCache[T]) All() []T {
+	var entities []T
+	c.scan(func(_ string, _ snowflake.ID, entity T) bool {
+		entities = append(entities, entity)
+		return true
+	})
+	return entities
+}
+
+func (c *storeCache[T]) MapAll() map[snowflake.ID]T {
+	entities := make(map[snowflake.ID]T)
+	c.scan(func(_ string, id snowflake.ID, entity T) bool {
+		entities[id] = entity
+		return true
+	})
+	return entities
+}
+
+func (c *storeCache[T]) FindFirst(cacheFindFunc FilterFunc[T]) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+	c.scan(func(_ string, _ snowflake.ID, entity T) bool {
+		if cacheFindFunc(entity) {
+			found = entity
+			ok = true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+func (c *storeCache[T]) FindAll(cacheFindFunc FilterFunc[T]) []T {
+	var entities []T
+	c.scan(func(_ string, _ snowflake.ID, entity T) bool {
+		if cacheFindFunc(entity) {
+			entities = append(entities, entity)
+		}
+		return true
+	})
+	return entities
+}
+
+func (c *storeCache[T]) ForEach(forEachFunc func(entity T)) {
+	c.scan(func(_ string, _ snowflake.ID, entity T) bool {
+		forEachFunc(entity)
+		return true
+	})
+}
+
+var _ GroupedCache[any] = (*storeGroupedCache[any])(nil)
+
+// NewStoreGroupedCache returns a new GroupedCache which serializes the entities as JSON into the given Store.
+// Keys are built as <keyPrefix>:<groupID>:<id>. Errors returned by the Store are passed to the errorHandler which may be nil.
+// Other than the default GroupedCache, the thread safety of this cache depends on the Store and operations like Remove are not atomic.
+func NewStoreGroupedCache[T any](store Store, keyPrefix string, flags Flags, neededFlags Flags, policy Policy[T], errorHandler StoreErrorHandler) GroupedCache[T] {
+	return &storeGroupedCache[T]{
+		store:        store,
+		keyPrefix:    keyPrefix + ":",
+		flags:        flags,
+		neededFlags:  neededFlags,
+		policy:       policy,
+		errorHandler: errorHandler,
+		unmarshal:    unmarshalEntity[T],
+	}
+}
+
+type storeGroupedCache[T any] struct {
+	store        Store
+	keyPrefix    string
+	flags        Flags
+	neededFlags  Flags
+	policy       Policy[T]
+	errorHandler StoreErrorHandler
+	unmarshal    entityUnmarshalFunc[T]
+}
+
+func (c *storeGroupedCache[T]) groupPrefix(groupID snowflake.ID) string {
+	return c.keyPrefix + groupID.String() + ":"
+}
+
+func (c *storeGroupedCache[T]) key(groupID snowflake.ID, id snowflake.ID) string {
+	return c.groupPrefix(groupID) + id.String()
+}
+
+func (c *storeGroupedCache[T]) handleErr(err error) {
+	if c.errorHandler != nil {
+		c.errorHandler(err)
+	}
+}
+
+func (c *storeGroupedCache[T]) decode(key string, data []byte) (T, bool) {
+	entity, err := c.unmarshal(data)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to unmarshal cache entity %s: %w", key, err))
+		return entity, false
+	}
+	return entity, true
+}
+
+// scan calls the given function for each decodable entity with keys starting with the given prefix until it returns false.
+func (c *storeGroupedCache[T]) scan(prefix string, scanFunc func(key string, groupID snowflake.ID, id snowflake.ID, entity T) bool) {
+	err := c.store.Scan(prefix, func(key string, value []byte) bool {
+		rawGroupID, rawID, _ := strings.Cut(strings.TrimPrefix(key, c.keyPrefix), ":")
+		groupID, err := snowflake.Parse(rawGroupID)
+		if err != nil {
+			c.handleErr(fmt.Errorf("invalid cache key %s: %w", key, err))
+			return true
+		}
+		id, err := snowflake.Parse(rawID)
+		if err != nil {
+			c.handleErr(fmt.Errorf("invalid cache key %s: %w", key, err))
+			return true
+		}
+		entity, ok := c.decode(key, value)
+		if !ok {
+			return true
+		}
+		return scanFunc(key, groupID, id, entity)
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", prefix, err))
+	}
+}
+
+func (c *storeGroupedCache[T]) count(prefix string) int {
+	var length int
+	err := c.store.Scan(prefix, func(_ string, _ []byte) bool {
+		length++
+		return true
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", prefix, err))
+	}
+	return length
+}
+
+func (c *storeGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	key := c.key(groupID, id)
+	data, ok, err := c.store.Get(key)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to get cache entity %s: %w", key, err))
+	}
+	if !ok || err != nil {
+		var entity T
+		return entity, false
+	}
+	return c.decode(key, data)
+}
+
+func (c *storeGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if c.flags.Missing(c.neededFlags) {
+		return
+	}
+	if c.policy != nil && !c.policy(entity) {
+		return
+	}
+	key := c.key(groupID, id)
+	data, err := json.Marshal(entity)
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to marshal cache entity %s: %w", key, err))
+		return
+	}
+	if err = c.store.Set(key, data); err != nil {
+		c.handleErr(fmt.Errorf("failed to set cache entity %s: %w", key, err))
+	}
+}
+
+func (c *storeGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	entity, ok := c.Get(groupID, id)
+	if !ok {
+		return entity, false
+	}
+	key := c.key(groupID, id)
+	if err := c.store.Delete(key); err != nil {
+		c.handleErr(fmt.Errorf("failed to delete cache entity %s: %w", key, err))
+		return entity, false
+	}
+	return entity, true
+}
+
+func (c *storeGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	var keys []string
+	err := c.store.Scan(c.groupPrefix(groupID), func(key string, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if err != nil {
+		c.handleErr(fmt.Errorf("failed to scan cache keys %s: %w", c.groupPrefix(groupID), err))
+		return
+	}
+	deleteKeys(c.store, keys, c.handleErr)
+}
+
+func (c *storeGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	var keys []string
+	c.scan(c.keyPrefix, func(key string, groupID snowflake.ID, _ snowflake.ID, entity T) bool {
+		if filterFunc(groupID, entity) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	deleteKeys(c.store, keys, c.handleErr)
+}
+
+func (c *storeGroupedCache[T]) Len() int {
+	return c.count(c.keyPrefix)
+}
+
+func (c *storeGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
+	return c.count(c.groupPrefix(groupID))
+}
+
+func (c *storeGroupedCache[T]) All() map[snowflake.ID][]T {
+	all := make(map[snowflake.ID][]T)
+	c.scan(c.keyPrefix, func(_ string, groupID snowflake.ID, _ snowflake.ID, entity T) bool {
+		all[groupID] = append(all[groupID], entity)
+		return true
+	})
+	return all
+}
+
+func (c *storeGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
+	var all []T
+	c.scan(c.groupPrefix(groupID), func(_ string, _ snowflake.ID, _ snowflake.ID, entity T) bool {
+		all = append(all, entity)
+		return true
+	})
+	return all
+}
+
+func (c *storeGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
+	all := make(map[snowflake.ID]map[snowflake.ID]T)
+	c.scan(c.keyPrefix, func(_ string, groupID snowflake.ID, id snowflake.ID, entity T) bool {
+		if _, ok := all[groupID]; !ok {
+			all[groupID] = make(map[snowflake.ID]T)
+		}
+		all[groupID][id] = entity
+		return true
+	})
+	return all
+}
+
+func (c *storeGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
+	var all map[snowflake.ID]T
+	c.scan(c.groupPrefix(groupID), func(_ string, _ snowflake.ID, id snowflake.ID, entity T) bool {
+		if all == nil {
+			all = make(map[snowflake.ID]T)
+		}
+		all[id] = entity
+		return true
+	})
+	return all
+}
+
+func (c *storeGroupedCache[T]) findFirst(prefix string, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+	c.scan(prefix, func(_ string, groupID snowflake.ID, _ snowflake.ID, entity T) bool {
+		if cacheFindFunc(groupID, entity) {
+			found = entity
+			ok = true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+func (c *storeGroupedCache[T]) FindFirst(cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	return c.findFirst(c.keyPrefix, cacheFindFunc)
+}
+
+func (c *storeGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	return c.findFirst(c.groupPrefix(groupID), cacheFindFunc)
+}
+
+func (c *storeGroupedCache[T]) findAll(prefix string, cacheFindFunc GroupedFilterFunc[T]) []T {
+	all := make([]T, 0)
+	c.scan(prefix, func(_ string, groupID snowflake.ID, _ snowflake.ID, entity T) bool {
+		if cacheFindFunc(groupID, entity) {
+			all = append(all, entity)
+		}
+		return true
+	})
+	return all
+}
+
+func (c *storeGroupedCache[T]) FindAll(cacheFindFunc GroupedFilterFunc[T]) []T {
+	return c.findAll(c.keyPrefix, cacheFindFunc)
+}
+
+func (c *storeGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) []T {
+	return c.findAll(c.groupPrefix(groupID), cacheFindFunc)
+}
+
+func (c *storeGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	c.scan(c.keyPrefix, func(_ string, groupID snowflake.ID, _ snowflake.ID, entity T) bool {
+		forEachFunc(groupID, entity)
+		return true
+	})
+}
+
+func (c *storeGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	c.scan(c.groupPrefix(groupID), func(_ string, _ snowflake.ID, _ snowflake.ID, entity T) bool {
+		forEachFunc(entity)
+		return true
+	})
+}
+
+// deleteKeys deletes the given keys from the Store after a scan finished, as deleting while scanning is not supported by all stores.
+func deleteKeys(store Store, keys []string, handleErr func(err error)) {
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			handleErr(fmt.Errorf("failed to delete cache entity %s: %w", key, err))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreGroupedCache(t *testing.T) {
+	store := NewMemoryStore()
+	c := NewStoreGroupedCache[discord.Role](store, "roles", FlagRoles, FlagRoles, nil, func(err error) {
+		t.Fatal(err)
+	})
+
+	c.Put(1, 10, discord.Role{ID: 10, Name: "a"})
+	c.Put(1, 11, discord.Role{ID: 11, Name: "b"})
+	c.Put(2, 20, discord.Role{ID: 20, Name: "c"})
+
+	role, ok := c.Get(1, 11)
+	assert.True(t, ok)
+	assert.Equal(t, "b", role.Name)
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, 2, c.GroupLen(1))
+	assert.Len(t, c.MapAll()[2], 1)
+
+	// a second cache on the same store sees the same state
+	other := NewStoreGroupedCache[discord.Role](store, "roles", FlagRoles, FlagRoles, nil, nil)
+	assert.ElementsMatch(t, []snowflake.ID{10, 11}, keys(other.MapGroupAll(1)))
+
+	c.RemoveAll(1)
+	assert.Equal(t, 0, other.GroupLen(1))
+
+	role, ok = c.Remove(2, 20)
+	assert.True(t, ok)
+	assert.Equal(t, "c", role.Name)
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestStoreChannelCache(t *testing.T) {
+	c := NewStoreChannelCache(NewMemoryStore(), "channels", FlagChannels, nil, func(err error) {
+		t.Fatal(err)
+	})
+
+	var channel discord.UnmarshalChannel
+	err := json.Unmarshal([]byte(`{"id":"1","type":0,"guild_id":"2","name":"general"}`), &channel)
+	assert.NoError(t, err)
+	c.Put(1, channel.Channel)
+
+	textChannel, ok := c.GetGuildTextChannel(1)
+	assert.True(t, ok)
+	assert.Equal(t, "general", textChannel.Name())
+	assert.Len(t, c.GuildChannels(2), 1)
+}
+
+func TestStoreGuildCache(t *testing.T) {
+	c := NewStoreGuildCache(NewMemoryStore(), "guilds", FlagGuilds, nil, nil)
+
+	c.Put(1, discord.Guild{ID: 1, Name: "test"})
+	c.SetUnready(0, 1)
+	assert.True(t, c.IsUnready(0, 1))
+	assert.Equal(t, []snowflake.ID{1}, c.UnreadyGuilds(0))
+	assert.Equal(t, 1, c.Len())
+
+	c.SetReady(0, 1)
+	assert.False(t, c.IsUnready(0, 1))
+
+	c.SetUnavailable(1)
+	assert.True(t, c.IsUnavailable(1))
+	_, ok := c.Get(1)
+	assert.False(t, ok)
+}
+
+func keys[T any](m map[snowflake.ID]T) []snowflake.ID {
+	ids := make([]snowflake.ID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -92,7 +92,7 @@ type SlashCommand struct {
 	DescriptionLocalizations map[Locale]string
 	DescriptionLocalized     string
 	Options                  []ApplicationCommandOption
-	defaultMemberPermissions Permissions
+	defaultMemberPermissions *Permissions
 	dmPermission             bool
 	nsfw                     bool
 	version                  snowflake.ID
@@ -170,7 +170,10 @@ func (c SlashCommand) NameLocalized() string {
 }
 
 func (c SlashCommand) DefaultMemberPermissions() Permissions {
-	return c.defaultMemberPermissions
+	if c.defaultMemberPermissions == nil {
+		return 0
+	}
+	return *c.defaultMemberPermissions
 }
 func (c SlashCommand) DMPermission() bool {
 	return c.dmPermission
@@ -203,7 +206,7 @@ type UserCommand struct {
 	name                     string
 	nameLocalizations        map[Locale]string
 	nameLocalized            string
-	defaultMemberPermissions Permissions
+	defaultMemberPermissions *Permissions
 	dmPermission             bool
 	nsfw                     bool
 	version                  snowflake.ID
@@ -273,7 +276,10 @@ func (c UserCommand) NameLocalized() string {
 }
 
 func (c UserCommand) DefaultMemberPermissions() Permissions {
-	return c.defaultMemberPermissions
+	if c.defaultMemberPermissions == nil {
+		return 0
+	}
+	return *c.defaultMemberPermissions
 }
 func (c UserCommand) DMPermission() bool {
 	return c.dmPermission
@@ -302,7 +308,7 @@ type MessageCommand struct {
 	name                     string
 	nameLocalizations        map[Locale]string
 	nameLocalized            string
-	defaultMemberPermissions Permissions
+	defaultMemberPermissions *Permissions
 	dmPermission             bool
 	nsfw                     bool
 	version                  snowflake.ID
@@ -372,7 +378,10 @@ func (c MessageCommand) NameLocalized() string {
 }
 
 func (c MessageCommand) DefaultMemberPermissions() Permissions {
-	return c.defaultMemberPermissions
+	if c.defaultMemberPermissions == nil {
+		return 0
+	}
+	return *c.defaultMemberPermissions
 }
 func (c MessageCommand) DMPermission() bool {
 	return c.dmPermission
@@ -17,7 +17,7 @@ type rawSlashCommand struct {
 	DescriptionLocalizations map[Locale]string          `json:"description_localizations,omitempty"`
 	DescriptionLocalized     string                     `json:"description_localized,omitempty"`
 	Options                  []ApplicationCommandOption `json:"options,omitempty"`
-	DefaultMemberPermissions Permissions                `json:"default_member_permissions"`
+	DefaultMemberPermissions *Permissions               `json:"default_member_permissions"`
 	DMPermission             bool                       `json:"dm_permission"`
 	NSFW                     bool                       `json:"nsfw"`
 	Version                  snowflake.ID               `json:"version"`
@@ -53,7 +53,7 @@ type rawContextCommand struct {
 	Name                     string                 `json:"name"`
 	NameLocalizations        map[Locale]string      `json:"name_localizations,omitempty"`
 	NameLocalized            string                 `json:"name_localized,omitempty"`
-	DefaultMemberPermissions Permissions            `json:"default_member_permissions"`
+	DefaultMemberPermissions *Permissions           `json:"default_member_permissions"`
 	DMPermission             bool                   `json:"dm_permission"`
 	NSFW                     bool                   `json:"nsfw"`
 	Version                  snowflake.ID           `json:"version"`
@@ -163,77 +163,73 @@ func (l *AuditLog) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	l.resolveEntries()
 	return nil
 }
 
+// resolveEntries sets the users, webhooks, integrations & threads of the AuditLog in the matching AuditLogEntry(s).
+func (l *AuditLog) resolveEntries() {
+	users := make(map[snowflake.ID]*User, len(l.Users))
+	for i := range l.Users {
+		users[l.Users[i].ID] = &l.Users[i]
+	}
+
+	for i := range l.AuditLogEntries {
+		entry := &l.AuditLogEntries[i]
+		entry.User = users[entry.UserID]
+		if entry.TargetID == nil {
+			continue
+		}
+		targetID := *entry.TargetID
+		entry.TargetUser = users[targetID]
+		for _, webhook := range l.Webhooks {
+			if webhook.ID() == targetID {
+				entry.TargetWebhook = webhook
+			}
+		}
+		for _, integration := range l.Integrations {
+			if integration.ID() == targetID {
+				entry.TargetIntegration = integration
+			}
+		}
+		for j := range l.Threads {
+			if l.Threads[j].ID() == targetID {
+				entry.TargetThread = &l.Threads[j]
+			}
+		}
+	}
+}
+
 // AuditLogEntry (https://discord.com/developers/docs/resources/audit-log#audit-log-entry-object)
 type AuditLogEntry struct {
 	TargetID   *snowflake.ID              `json:"target_id"`
-	Changes    []AuditLogChangeKey        `json:"changes"`
+	Changes    []AuditLogChange           `json:"changes"`
 	UserID     snowflake.ID               `json:"user_id"`
 	ID         snowflake.ID               `json:"id"`
 	ActionType AuditLogEvent              `json:"action_type"`
 	Options    *OptionalAuditLogEntryInfo `json:"options"`
 	Reason     *string                    `json:"reason"`
+
+	// User is the User who made the changes. It is resolved from AuditLog.Users.
+	User *User `json:"-"`
+	// TargetUser is the User affected by the changes if the target is a User. It is resolved from AuditLog.Users.
+	TargetUser *User `json:"-"`
+	// TargetWebhook is the Webhook affected by the changes if the target is a Webhook. It is resolved from AuditLog.Webhooks.
+	TargetWebhook Webhook `json:"-"`
+	// TargetIntegration is the Integration affected by the changes if the target is an Integration. It is resolved from AuditLog.Integrations.
+	TargetIntegration Integration `json:"-"`
+	// TargetThread is the GuildThread affected by the changes if the target is a GuildThread. It is resolved from AuditLog.Threads.
+	TargetThread *GuildThread `json:"-"`
 }
 
-// AuditLogChangeKey (https://discord.com/developers/docs/resources/audit-log#audit-log-change-object-audit-log-change-key) is data representing changes values/settings in an audit log.
-type AuditLogChangeKey struct {
-	Name                        *string                     `json:"name"`
-	Description                 *string                     `json:"description"`
-	IconHash                    *string                     `json:"icon_hash"`
-	SplashHash                  *string                     `json:"splash_hash"`
-	DiscoverySplashHash         *string                     `json:"discovery_splash_hash"`
-	BannerHash                  *string                     `json:"banner_hash"`
-	OwnerID                     *snowflake.ID               `json:"owner_id"`
-	Region                      *string                     `json:"region"`
-	PreferredLocale             *string                     `json:"preferred_locale"`
-	AFKChannelID                *snowflake.ID               `json:"afk_channel_id"`
-	AFKTimeout                  *int                        `json:"afk_timeout"`
-	RulesChannelID              *snowflake.ID               `json:"rules_channel_id"`
-	PublicUpdatesChannelID      *snowflake.ID               `json:"public_updates_channel_id"`
-	MFALevel                    *MFALevel                   `json:"mfa_level"`
-	VerificationLevel           *VerificationLevel          `json:"verification_level"`
-	ExplicitContentFilterLevel  *ExplicitContentFilterLevel `json:"explicit_content_filter"`
-	DefaultMessageNotifications *MessageNotificationsLevel  `json:"default_message_notifications"`
-	VanityURLCode               *string                     `json:"vanity_url_code"`
-	Add                         []PartialRole               `json:"$add"`
-	Remove                      []PartialRole               `json:"$remove"`
-	PruneDeleteDays             *int                        `json:"prune_delete_days"`
-	WidgetEnabled               *bool                       `json:"widget_enabled"`
-	WidgetChannelID             *string                     `json:"widget_channel_id"`
-	SystemChannelID             *string                     `json:"system_channel_id"`
-	Position                    *int                        `json:"position"`
-	Topic                       *string                     `json:"topic"`
-	Bitrate                     *int                        `json:"bitrate"`
-	PermissionOverwrites        []PermissionOverwrite       `json:"permission_overwrites"`
-	NSFW                        *bool                       `json:"nsfw"`
-	ApplicationID               *snowflake.ID               `json:"application_id"`
-	RateLimitPerUser            *int                        `json:"ratelimit_per_user"`
-	Permissions                 *string                     `json:"permissions"`
-	Color                       *int                        `json:"color"`
-	Hoist                       *bool                       `json:"hoist"`
-	Mentionable                 *bool                       `json:"mentionable"`
-	Allow                       *Permissions                `json:"allow"`
-	Deny                        *Permissions                `json:"deny"`
-	Code                        *string                     `json:"code"`
-	ChannelID                   *snowflake.ID               `json:"channel_id"`
-	InviterID                   *snowflake.ID               `json:"inviter_id"`
-	MaxUses                     *int                        `json:"max_uses"`
-	Uses                        *int                        `json:"uses"`
-	MaxAge                      *string                     `json:"max_age"`
-	Temporary                   *bool                       `json:"temporary"`
-	Deaf                        *bool                       `json:"deaf"`
-	Mute                        *bool                       `json:"mute"`
-	Nick                        *string                     `json:"nick"`
-	AvatarHash                  *string                     `json:"avatar_hash"`
-	ID                          *snowflake.ID               `json:"id"`
-	Type                        any                         `json:"type"`
-	EnableEmoticons             *bool                       `json:"enable_emoticons"`
-	ExpireBehavior              *int                        `json:"expire_behavior"`
-	ExpireGracePeriod           *int                        `json:"expire_grace_period"`
-	UserLimit                   *int                        `json:"user_limit"`
-	PrivacyLevel                *StagePrivacyLevel          `json:"privacy_level"`
+// Change returns the AuditLogChange with the given AuditLogChangeKey.
+func (e AuditLogEntry) Change(key AuditLogChangeKey) (AuditLogChange, bool) {
+	for _, change := range e.Changes {
+		if change.Key == key {
+			return change, true
+		}
+	}
+	return AuditLogChange{}, false
 }
 
 // OptionalAuditLogEntryInfo (https://discord.com/developers/docs/resources/audit-log#audit-log-entry-object-optional-audit-entry-info)
@@ -0,0 +1,184 @@
+package discord
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// AuditLogChangeKey (https://discord.com/developers/docs/resources/audit-log#audit-log-change-object-audit-log-change-key) is the name of the changed value of an entity.
+type AuditLogChangeKey string
+
+// All AuditLogChangeKey(s)
+const (
+	AuditLogChangeKeyAFKChannelID                AuditLogChangeKey = "afk_channel_id"
+	AuditLogChangeKeyAFKTimeout                  AuditLogChangeKey = "afk_timeout"
+	AuditLogChangeKeyAllow                       AuditLogChangeKey = "allow"
+	AuditLogChangeKeyApplicationID               AuditLogChangeKey = "application_id"
+	AuditLogChangeKeyArchived                    AuditLogChangeKey = "archived"
+	AuditLogChangeKeyAsset                       AuditLogChangeKey = "asset"
+	AuditLogChangeKeyAutoArchiveDuration         AuditLogChangeKey = "auto_archive_duration"
+	AuditLogChangeKeyAvailable                   AuditLogChangeKey = "available"
+	AuditLogChangeKeyAvatarHash                  AuditLogChangeKey = "avatar_hash"
+	AuditLogChangeKeyBannerHash                  AuditLogChangeKey = "banner_hash"
+	AuditLogChangeKeyBitrate                     AuditLogChangeKey = "bitrate"
+	AuditLogChangeKeyChannelID                   AuditLogChangeKey = "channel_id"
+	AuditLogChangeKeyCode                        AuditLogChangeKey = "code"
+	AuditLogChangeKeyColor                       AuditLogChangeKey = "color"
+	AuditLogChangeKeyCommunicationDisabledUntil  AuditLogChangeKey = "communication_disabled_until"
+	AuditLogChangeKeyDeaf                        AuditLogChangeKey = "deaf"
+	AuditLogChangeKeyDefaultAutoArchiveDuration  AuditLogChangeKey = "default_auto_archive_duration"
+	AuditLogChangeKeyDefaultMessageNotifications AuditLogChangeKey = "default_message_notifications"
+	AuditLogChangeKeyDeny                        AuditLogChangeKey = "deny"
+	AuditLogChangeKeyDescription                 AuditLogChangeKey = "description"
+	AuditLogChangeKeyDiscoverySplashHash         AuditLogChangeKey = "discovery_splash_hash"
+	AuditLogChangeKeyEnableEmoticons             AuditLogChangeKey = "enable_emoticons"
+	AuditLogChangeKeyEntityType                  AuditLogChangeKey = "entity_type"
+	AuditLogChangeKeyExpireBehavior              AuditLogChangeKey = "expire_behavior"
+	AuditLogChangeKeyExpireGracePeriod           AuditLogChangeKey = "expire_grace_period"
+	AuditLogChangeKeyExplicitContentFilter       AuditLogChangeKey = "explicit_content_filter"
+	AuditLogChangeKeyFormatType                  AuditLogChangeKey = "format_type"
+	AuditLogChangeKeyGuildID                     AuditLogChangeKey = "guild_id"
+	AuditLogChangeKeyHoist                       AuditLogChangeKey = "hoist"
+	AuditLogChangeKeyIconHash                    AuditLogChangeKey = "icon_hash"
+	AuditLogChangeKeyImageHash                   AuditLogChangeKey = "image_hash"
+	AuditLogChangeKeyID                          AuditLogChangeKey = "id"
+	AuditLogChangeKeyInvitable                   AuditLogChangeKey = "invitable"
+	AuditLogChangeKeyInviterID                   AuditLogChangeKey = "inviter_id"
+	AuditLogChangeKeyLocation                    AuditLogChangeKey = "location"
+	AuditLogChangeKeyLocked                      AuditLogChangeKey = "locked"
+	AuditLogChangeKeyMaxAge                      AuditLogChangeKey = "max_age"
+	AuditLogChangeKeyMaxUses                     AuditLogChangeKey = "max_uses"
+	AuditLogChangeKeyMentionable                 AuditLogChangeKey = "mentionable"
+	AuditLogChangeKeyMFALevel                    AuditLogChangeKey = "mfa_level"
+	AuditLogChangeKeyMute                        AuditLogChangeKey = "mute"
+	AuditLogChangeKeyName                        AuditLogChangeKey = "name"
+	AuditLogChangeKeyNick                        AuditLogChangeKey = "nick"
+	AuditLogChangeKeyNSFW                        AuditLogChangeKey = "nsfw"
+	AuditLogChangeKeyOwnerID                     AuditLogChangeKey = "owner_id"
+	AuditLogChangeKeyPermissionOverwrites        AuditLogChangeKey = "permission_overwrites"
+	AuditLogChangeKeyPermissions                 AuditLogChangeKey = "permissions"
+	AuditLogChangeKeyPosition                    AuditLogChangeKey = "position"
+	AuditLogChangeKeyPreferredLocale             AuditLogChangeKey = "preferred_locale"
+	AuditLogChangeKeyPrivacyLevel                AuditLogChangeKey = "privacy_level"
+	AuditLogChangeKeyPruneDeleteDays             AuditLogChangeKey = "prune_delete_days"
+	AuditLogChangeKeyPublicUpdatesChannelID      AuditLogChangeKey = "public_updates_channel_id"
+	AuditLogChangeKeyRateLimitPerUser            AuditLogChangeKey = "rate_limit_per_user"
+	AuditLogChangeKeyRegion                      AuditLogChangeKey = "region"
+	AuditLogChangeKeyRulesChannelID              AuditLogChangeKey = "rules_channel_id"
+	AuditLogChangeKeySplashHash                  AuditLogChangeKey = "splash_hash"
+	AuditLogChangeKeyStatus                      AuditLogChangeKey = "status"
+	AuditLogChangeKeySystemChannelID             AuditLogChangeKey = "system_channel_id"
+	AuditLogChangeKeyTags                        AuditLogChangeKey = "tags"
+	AuditLogChangeKeyTemporary                   AuditLogChangeKey = "temporary"
+	AuditLogChangeKeyTopic                       AuditLogChangeKey = "topic"
+	AuditLogChangeKeyType                        AuditLogChangeKey = "type"
+	AuditLogChangeKeyUnicodeEmoji                AuditLogChangeKey = "unicode_emoji"
+	AuditLogChangeKeyUserLimit                   AuditLogChangeKey = "user_limit"
+	AuditLogChangeKeyUses                        AuditLogChangeKey = "uses"
+	AuditLogChangeKeyVanityURLCode               AuditLogChangeKey = "vanity_url_code"
+	AuditLogChangeKeyVerificationLevel           AuditLogChangeKey = "verification_level"
+	AuditLogChangeKeyWidgetChannelID             AuditLogChangeKey = "widget_channel_id"
+	AuditLogChangeKeyWidgetEnabled               AuditLogChangeKey = "widget_enabled"
+	AuditLogChangeKeyRoleAdd                     AuditLogChangeKey = "$add"
+	AuditLogChangeKeyRoleRemove                  AuditLogChangeKey = "$remove"
+)
+
+// AuditLogChange (https://discord.com/developers/docs/resources/audit-log#audit-log-change-object) is a single value of an entity which was changed.
+// OldValue is empty if the value was added & NewValue is empty if the value was removed.
+// The type of the values depends on the Key, use the typed accessors to read them.
+type AuditLogChange struct {
+	Key      AuditLogChangeKey `json:"key"`
+	OldValue json.RawMessage   `json:"old_value,omitempty"`
+	NewValue json.RawMessage   `json:"new_value,omitempty"`
+}
+
+// UnmarshalOldValue unmarshals the OldValue into v.
+func (c AuditLogChange) UnmarshalOldValue(v any) error {
+	return json.Unmarshal(c.OldValue, v)
+}
+
+// UnmarshalNewValue unmarshals the NewValue into v.
+func (c AuditLogChange) UnmarshalNewValue(v any) error {
+	return json.Unmarshal(c.NewValue, v)
+}
+
+// StringValues returns the old & new value of a change with a string value like AuditLogChangeKeyName.
+func (c AuditLogChange) StringValues() (*string, *string, error) {
+	return auditLogChangeValues[string](c)
+}
+
+// IntValues returns the old & new value of a change with an int value like AuditLogChangeKeyPosition.
+func (c AuditLogChange) IntValues() (*int, *int, error) {
+	return auditLogChangeValues[int](c)
+}
+
+// BoolValues returns the old & new value of a change with a bool value like AuditLogChangeKeyNSFW.
+func (c AuditLogChange) BoolValues() (*bool, *bool, error) {
+	return auditLogChangeValues[bool](c)
+}
+
+// SnowflakeValues returns the old & new value of a change with a snowflake.ID value like AuditLogChangeKeyOwnerID.
+func (c AuditLogChange) SnowflakeValues() (*snowflake.ID, *snowflake.ID, error) {
+	return auditLogChangeValues[snowflake.ID](c)
+}
+
+// PermissionsValues returns the old & new value of AuditLogChangeKeyPermissions, AuditLogChangeKeyAllow & AuditLogChangeKeyDeny.
+func (c AuditLogChange) PermissionsValues() (*Permissions, *Permissions, error) {
+	return auditLogChangeValues[Permissions](c)
+}
+
+// TimeValues returns the old & new value of a change with a timestamp value like AuditLogChangeKeyCommunicationDisabledUntil.
+func (c AuditLogChange) TimeValues() (*time.Time, *time.Time, error) {
+	return auditLogChangeValues[time.Time](c)
+}
+
+// PermissionOverwritesValues returns the old & new value of AuditLogChangeKeyPermissionOverwrites.
+func (c AuditLogChange) PermissionOverwritesValues() (PermissionOverwrites, PermissionOverwrites, error) {
+	oldOverwrites, newOverwrites, err := auditLogChangeValues[[]UnmarshalPermissionOverwrite](c)
+	if err != nil {
+		return nil, nil, err
+	}
+	var oldValue, newValue PermissionOverwrites
+	if oldOverwrites != nil {
+		oldValue = parsePermissionOverwrites(*oldOverwrites)
+	}
+	if newOverwrites != nil {
+		newValue = parsePermissionOverwrites(*newOverwrites)
+	}
+	return oldValue, newValue, nil
+}
+
+// Roles returns the roles which were added or removed by AuditLogChangeKeyRoleAdd & AuditLogChangeKeyRoleRemove.
+func (c AuditLogChange) Roles() ([]PartialRole, error) {
+	_, roles, err := auditLogChangeValues[[]PartialRole](c)
+	if err != nil || roles == nil {
+		return nil, err
+	}
+	return *roles, nil
+}
+
+func auditLogChangeValues[T any](c AuditLogChange) (*T, *T, error) {
+	oldValue, err := auditLogChangeValue[T](c.OldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	newValue, err := auditLogChangeValue[T](c.NewValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	return oldValue, newValue, nil
+}
+
+func auditLogChangeValue[T any](data json.RawMessage) (*T, error) {
+	if len(data) == 0 || bytes.Equal(data, json.NullBytes) {
+		return nil, nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,69 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"audit_log_entries": [
+			{
+				"id": "10",
+				"user_id": "1",
+				"target_id": "2",
+				"action_type": 25,
+				"changes": [
+					{"key": "$add", "new_value": [{"id": "3", "name": "Mod"}]},
+					{"key": "nick", "old_value": "old", "new_value": "new"},
+					{"key": "permissions", "old_value": "0", "new_value": "8"},
+					{"key": "permission_overwrites", "new_value": [{"id": "3", "type": 0, "allow": "1024", "deny": "0"}]}
+				]
+			}
+		],
+		"users": [{"id": "1", "username": "moderator"}, {"id": "2", "username": "member"}],
+		"webhooks": [],
+		"integrations": [],
+		"threads": []
+	}`)
+
+	var auditLog AuditLog
+	require.NoError(t, json.Unmarshal(data, &auditLog))
+	require.Len(t, auditLog.AuditLogEntries, 1)
+	entry := auditLog.AuditLogEntries[0]
+
+	require.NotNil(t, entry.User)
+	assert.Equal(t, "moderator", entry.User.Username)
+	require.NotNil(t, entry.TargetUser)
+	assert.Equal(t, "member", entry.TargetUser.Username)
+
+	change, ok := entry.Change(AuditLogChangeKeyRoleAdd)
+	require.True(t, ok)
+	roles, err := change.Roles()
+	require.NoError(t, err)
+	assert.Equal(t, []PartialRole{{ID: 3, Name: "Mod"}}, roles)
+
+	change, _ = entry.Change(AuditLogChangeKeyNick)
+	oldNick, newNick, err := change.StringValues()
+	require.NoError(t, err)
+	assert.Equal(t, "old", *oldNick)
+	assert.Equal(t, "new", *newNick)
+
+	change, _ = entry.Change(AuditLogChangeKeyPermissions)
+	oldPermissions, newPermissions, err := change.PermissionsValues()
+	require.NoError(t, err)
+	assert.Equal(t, PermissionsNone, *oldPermissions)
+	assert.Equal(t, PermissionAdministrator, *newPermissions)
+
+	change, _ = entry.Change(AuditLogChangeKeyPermissionOverwrites)
+	oldOverwrites, newOverwrites, err := change.PermissionOverwritesValues()
+	require.NoError(t, err)
+	assert.Nil(t, oldOverwrites)
+	overwrite, ok := newOverwrites.Role(snowflake.ID(3))
+	require.True(t, ok)
+	assert.Equal(t, PermissionViewChannel, overwrite.Allow)
+}
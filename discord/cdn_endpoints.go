@@ -4,6 +4,7 @@ import (
 	"strings"
 )
 
+// CDN is the default base url of the Discord CDN. It can be overridden per call with WithCDNURL.
 const CDN = "https://cdn.discordapp.com"
 
 var (
@@ -78,22 +79,30 @@ type CDNEndpoint struct {
 	Formats []ImageFormat
 }
 
+// URL returns the url of the CDNEndpoint with the given ImageFormat, query values & url params using the default CDN base url
 func (e CDNEndpoint) URL(format ImageFormat, values QueryValues, params ...any) string {
+	return e.CompileURL(CDN, format, values, params...)
+}
+
+// CompileURL returns the url of the CDNEndpoint with the given CDN base url, ImageFormat, query values & url params
+func (e CDNEndpoint) CompileURL(cdnURL string, format ImageFormat, values QueryValues, params ...any) string {
 	query := values.Encode()
 	if query != "" {
 		query = "?" + query
 	}
-	return urlPrint(CDN+e.Route+"."+format.String(), params...) + query
+	return urlPrint(strings.TrimSuffix(cdnURL, "/")+e.Route+"."+format.String(), params...) + query
 }
 
 func DefaultCDNConfig() *CDNConfig {
 	return &CDNConfig{
+		URL:    CDN,
 		Format: ImageFormatPNG,
 		Values: QueryValues{},
 	}
 }
 
 type CDNConfig struct {
+	URL    string
 	Format ImageFormat
 	Values QueryValues
 }
@@ -113,6 +122,13 @@ func WithSize(size int) CDNOpt {
 	}
 }
 
+// WithCDNURL sets the base url of the CDN, for example to target a mock server or staging environment.
+func WithCDNURL(url string) CDNOpt {
+	return func(config *CDNConfig) {
+		config.URL = url
+	}
+}
+
 func WithFormat(format ImageFormat) CDNOpt {
 	return func(config *CDNConfig) {
 		config.Format = format
@@ -141,5 +157,5 @@ func formatAssetURL(cdnRoute *CDNEndpoint, opts []CDNOpt, params ...any) string
 		config.Format = ImageFormatGIF
 	}
 
-	return cdnRoute.URL(config.Format, config.Values, params...)
+	return cdnRoute.CompileURL(config.URL, config.Format, config.Values, params...)
 }
@@ -0,0 +1,14 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCDNURL(t *testing.T) {
+	user := User{ID: 1, Avatar: json.Ptr("hash")}
+	assert.Equal(t, CDN+"/avatars/1/hash.png", *user.AvatarURL())
+	assert.Equal(t, "http://localhost/cdn/avatars/1/hash.png", *user.AvatarURL(WithCDNURL("http://localhost/cdn/")))
+}
@@ -0,0 +1,310 @@
+package discord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// PermissionsImplicitSendMessages are the Permissions which are implicitly denied if a member can't send messages in a channel.
+// See https://discord.com/developers/docs/topics/permissions#implicit-permissions
+const PermissionsImplicitSendMessages = PermissionMentionEveryone |
+	PermissionSendTTSMessages |
+	PermissionAttachFiles |
+	PermissionEmbedLinks
+
+// PermissionsImplicitVoiceConnect are the Permissions which are implicitly denied if a member can't connect to a voice or stage channel.
+const PermissionsImplicitVoiceConnect = PermissionVoiceSpeak |
+	PermissionVoiceMuteMembers |
+	PermissionVoiceDeafenMembers |
+	PermissionVoiceMoveMembers |
+	PermissionVoiceUseVAD |
+	PermissionVoicePrioritySpeaker |
+	PermissionRequestToSpeak |
+	PermissionStartEmbeddedActivities
+
+// PermissionsTimedOut are the Permissions a member keeps while timed out.
+const PermissionsTimedOut = PermissionViewChannel | PermissionReadMessageHistory
+
+// MemberPermissions contains everything needed to compute the Permissions of a Member in a guild or channel.
+// See https://discord.com/developers/docs/topics/permissions#permission-overwrites
+type MemberPermissions struct {
+	// Member is the Member to compute the Permissions of.
+	Member Member
+	// OwnerID is the ID of the owner of the guild.
+	OwnerID snowflake.ID
+	// Roles are the roles of the guild. At least the @everyone role & the roles of the Member are required.
+	Roles []Role
+	// Channel is the channel to compute the Permissions in or nil to compute the guild Permissions.
+	Channel GuildChannel
+	// Parent is the parent channel of Channel if it is a GuildThread. Threads don't have own PermissionOverwrites and use the ones of their parent.
+	Parent GuildChannel
+	// ThreadMember is whether the Member is a member of Channel if it is a private GuildThread.
+	ThreadMember bool
+	// Now is the time used to check whether the timeout of the Member is active. Defaults to time.Now.
+	Now time.Time
+}
+
+// Permissions returns the computed Permissions of the Member.
+func (p MemberPermissions) Permissions() Permissions {
+	return p.Explain().Permissions
+}
+
+// Explain computes the Permissions of the Member & keeps track of which role, overwrite or rule allowed or denied each permission.
+func (p MemberPermissions) Explain() PermissionsExplanation {
+	e := PermissionsExplanation{explanations: map[Permissions]PermissionExplanation{}}
+	guildID := p.Member.GuildID
+
+	if p.Member.User.ID == p.OwnerID {
+		e.set(PermissionsAll, true, PermissionReasonOwner, p.OwnerID)
+		return e
+	}
+
+	roles := make(map[snowflake.ID]Role, len(p.Roles))
+	for _, role := range p.Roles {
+		roles[role.ID] = role
+	}
+	if everyone, ok := roles[guildID]; ok {
+		e.set(everyone.Permissions, true, PermissionReasonRole, guildID)
+	}
+	memberRoles := p.memberRoles(roles)
+	for _, role := range memberRoles {
+		// keep the first role which allowed the permission
+		e.set(role.Permissions&^e.Permissions, true, PermissionReasonRole, role.ID)
+	}
+	if e.Permissions.Has(PermissionAdministrator) {
+		e.set(PermissionsAll, true, PermissionReasonAdministrator, e.Explanation(PermissionAdministrator).ID)
+		return e
+	}
+
+	if channel := p.overwriteChannel(); channel != nil {
+		overwrites := channel.PermissionOverwrites()
+		if overwrite, ok := overwrites.Role(guildID); ok {
+			e.set(overwrite.Deny, false, PermissionReasonRoleOverwrite, guildID)
+			e.set(overwrite.Allow, true, PermissionReasonRoleOverwrite, guildID)
+		}
+
+		// role overwrites are applied together, an allow of any role wins over a deny of another role
+		var roleOverwrites []RolePermissionOverwrite
+		for _, role := range memberRoles {
+			if overwrite, ok := overwrites.Role(role.ID); ok {
+				roleOverwrites = append(roleOverwrites, overwrite)
+			}
+		}
+		for _, overwrite := range roleOverwrites {
+			e.set(overwrite.Deny, false, PermissionReasonRoleOverwrite, overwrite.RoleID)
+		}
+		for _, overwrite := range roleOverwrites {
+			e.set(overwrite.Allow, true, PermissionReasonRoleOverwrite, overwrite.RoleID)
+		}
+
+		if overwrite, ok := overwrites.Member(p.Member.User.ID); ok {
+			e.set(overwrite.Deny, false, PermissionReasonMemberOverwrite, p.Member.User.ID)
+			e.set(overwrite.Allow, true, PermissionReasonMemberOverwrite, p.Member.User.ID)
+		}
+	}
+
+	if p.Channel != nil {
+		p.applyImplicit(&e)
+	}
+
+	if until := p.Member.CommunicationDisabledUntil; until != nil && until.After(p.now()) {
+		e.setImplicit(e.Permissions&^PermissionsTimedOut, PermissionReasonTimeout, p.Member.User.ID, PermissionsNone)
+	}
+	return e
+}
+
+// applyImplicit denies the Permissions which are implicitly denied by missing other Permissions in the channel.
+func (p MemberPermissions) applyImplicit(e *PermissionsExplanation) {
+	if e.Permissions.Missing(PermissionViewChannel) {
+		e.setImplicit(e.Permissions, PermissionReasonImplicit, 0, PermissionViewChannel)
+		return
+	}
+
+	sendPermission := PermissionSendMessages
+	switch p.Channel.Type() {
+	case ChannelTypeGuildNewsThread, ChannelTypeGuildPublicThread, ChannelTypeGuildPrivateThread:
+		sendPermission = PermissionSendMessagesInThreads
+		// private threads are only visible to their members & members who can manage threads
+		if p.Channel.Type() == ChannelTypeGuildPrivateThread && !p.ThreadMember && e.Permissions.Missing(PermissionManageThreads) {
+			e.setImplicit(e.Permissions, PermissionReasonImplicit, 0, PermissionManageThreads)
+			return
+		}
+
+	case ChannelTypeGuildVoice, ChannelTypeGuildStageVoice:
+		if e.Permissions.Missing(PermissionVoiceConnect) {
+			e.setImplicit(e.Permissions&PermissionsImplicitVoiceConnect, PermissionReasonImplicit, 0, PermissionVoiceConnect)
+		}
+	}
+
+	if e.Permissions.Missing(sendPermission) {
+		e.setImplicit(e.Permissions&PermissionsImplicitSendMessages, PermissionReasonImplicit, 0, sendPermission)
+	}
+}
+
+// memberRoles returns the roles of the Member without the @everyone role ordered by their position from lowest to highest.
+func (p MemberPermissions) memberRoles(roles map[snowflake.ID]Role) []Role {
+	memberRoles := make([]Role, 0, len(p.Member.RoleIDs))
+	for _, roleID := range p.Member.RoleIDs {
+		if role, ok := roles[roleID]; ok && roleID != p.Member.GuildID {
+			memberRoles = append(memberRoles, role)
+		}
+	}
+	sort.SliceStable(memberRoles, func(i, j int) bool {
+		return memberRoles[i].Position < memberRoles[j].Position
+	})
+	return memberRoles
+}
+
+// overwriteChannel returns the channel whose PermissionOverwrites apply.
+func (p MemberPermissions) overwriteChannel() GuildChannel {
+	if _, ok := p.Channel.(GuildThread); ok {
+		return p.Parent
+	}
+	return p.Channel
+}
+
+func (p MemberPermissions) now() time.Time {
+	if p.Now.IsZero() {
+		return time.Now()
+	}
+	return p.Now
+}
+
+// PermissionReason is the reason a permission was allowed or denied.
+type PermissionReason int
+
+// All PermissionReason(s)
+const (
+	// PermissionReasonNone means no role or overwrite allowed the permission.
+	PermissionReasonNone PermissionReason = iota
+	// PermissionReasonOwner means the member owns the guild.
+	PermissionReasonOwner
+	// PermissionReasonAdministrator means the role with the ID has the PermissionAdministrator.
+	PermissionReasonAdministrator
+	// PermissionReasonRole means the role with the ID allowed the permission.
+	PermissionReasonRole
+	// PermissionReasonRoleOverwrite means the overwrite of the role with the ID allowed or denied the permission.
+	PermissionReasonRoleOverwrite
+	// PermissionReasonMemberOverwrite means the overwrite of the member allowed or denied the permission.
+	PermissionReasonMemberOverwrite
+	// PermissionReasonImplicit means the permission was denied because the member is missing another permission.
+	PermissionReasonImplicit
+	// PermissionReasonTimeout means the permission was denied because the member is timed out.
+	PermissionReasonTimeout
+)
+
+func (r PermissionReason) String() string {
+	switch r {
+	case PermissionReasonNone:
+		return "None"
+	case PermissionReasonOwner:
+		return "Owner"
+	case PermissionReasonAdministrator:
+		return "Administrator"
+	case PermissionReasonRole:
+		return "Role"
+	case PermissionReasonRoleOverwrite:
+		return "Role Overwrite"
+	case PermissionReasonMemberOverwrite:
+		return "Member Overwrite"
+	case PermissionReasonImplicit:
+		return "Implicit"
+	case PermissionReasonTimeout:
+		return "Timeout"
+	default:
+		return "Unknown"
+	}
+}
+
+// PermissionExplanation explains why a single permission was allowed or denied.
+type PermissionExplanation struct {
+	Permission Permissions
+	Allowed    bool
+	Reason     PermissionReason
+	// ID is the ID of the role or member of the Reason.
+	ID snowflake.ID
+	// Missing is the permission whose absence implicitly denied the Permission if the Reason is PermissionReasonImplicit.
+	Missing Permissions
+}
+
+func (e PermissionExplanation) String() string {
+	verb := "denied"
+	if e.Allowed {
+		verb = "allowed"
+	}
+	switch e.Reason {
+	case PermissionReasonNone:
+		return fmt.Sprintf("%s: not allowed by any role", e.Permission)
+	case PermissionReasonOwner:
+		return fmt.Sprintf("%s: allowed as guild owner", e.Permission)
+	case PermissionReasonImplicit:
+		return fmt.Sprintf("%s: implicitly denied by missing %s", e.Permission, e.Missing)
+	case PermissionReasonTimeout:
+		return fmt.Sprintf("%s: denied by timeout", e.Permission)
+	default:
+		return fmt.Sprintf("%s: %s by %s %d", e.Permission, verb, strings.ToLower(e.Reason.String()), e.ID)
+	}
+}
+
+// PermissionsExplanation contains the computed Permissions & a PermissionExplanation for each permission.
+type PermissionsExplanation struct {
+	Permissions  Permissions
+	explanations map[Permissions]PermissionExplanation
+}
+
+// Explanation returns the PermissionExplanation of the given single permission.
+func (e PermissionsExplanation) Explanation(permission Permissions) PermissionExplanation {
+	if explanation, ok := e.explanations[permission]; ok {
+		return explanation
+	}
+	return PermissionExplanation{Permission: permission}
+}
+
+// Explanations returns the PermissionExplanation of all known permissions ordered by their bit.
+func (e PermissionsExplanation) Explanations() []PermissionExplanation {
+	explanations := make([]PermissionExplanation, 0, len(permissions))
+	for permission := range permissions {
+		explanations = append(explanations, e.Explanation(permission))
+	}
+	sort.Slice(explanations, func(i, j int) bool {
+		return explanations[i].Permission < explanations[j].Permission
+	})
+	return explanations
+}
+
+func (e PermissionsExplanation) String() string {
+	var sb strings.Builder
+	for _, explanation := range e.Explanations() {
+		sb.WriteString(explanation.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (e *PermissionsExplanation) set(bits Permissions, allowed bool, reason PermissionReason, id snowflake.ID) {
+	e.setExplanation(bits, PermissionExplanation{Allowed: allowed, Reason: reason, ID: id})
+}
+
+func (e *PermissionsExplanation) setImplicit(bits Permissions, reason PermissionReason, id snowflake.ID, missing Permissions) {
+	e.setExplanation(bits, PermissionExplanation{Reason: reason, ID: id, Missing: missing})
+}
+
+func (e *PermissionsExplanation) setExplanation(bits Permissions, explanation PermissionExplanation) {
+	for i := 0; i < 63; i++ {
+		bit := Permissions(1 << i)
+		if !bits.Has(bit) {
+			continue
+		}
+		explanation.Permission = bit
+		e.explanations[bit] = explanation
+		if explanation.Allowed {
+			e.Permissions |= bit
+		} else {
+			e.Permissions &^= bit
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberPermissions(t *testing.T) {
+	const (
+		guildID   = snowflake.ID(1)
+		ownerID   = snowflake.ID(2)
+		userID    = snowflake.ID(3)
+		modRoleID = snowflake.ID(4)
+		adminID   = snowflake.ID(5)
+		channelID = snowflake.ID(6)
+	)
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	everyone := Role{ID: guildID, Permissions: PermissionViewChannel | PermissionSendMessages | PermissionSendMessagesInThreads | PermissionReadMessageHistory | PermissionEmbedLinks | PermissionVoiceConnect | PermissionVoiceSpeak}
+	mod := Role{ID: modRoleID, Position: 1, Permissions: PermissionManageMessages | PermissionManageThreads}
+	admin := Role{ID: adminID, Position: 2, Permissions: PermissionAdministrator}
+	roles := []Role{everyone, mod, admin}
+
+	member := func(until *time.Time, roleIDs ...snowflake.ID) Member {
+		return Member{User: User{ID: userID}, GuildID: guildID, RoleIDs: roleIDs, CommunicationDisabledUntil: until}
+	}
+	text := func(overwrites ...PermissionOverwrite) GuildTextChannel {
+		return GuildTextChannel{id: channelID, guildID: guildID, permissionOverwrites: overwrites}
+	}
+	thread := func(channelType ChannelType) GuildThread {
+		return GuildThread{id: 7, channelType: channelType, guildID: guildID, parentID: channelID}
+	}
+
+	tests := []struct {
+		name        string
+		permissions MemberPermissions
+		has         Permissions
+		missing     Permissions
+	}{
+		{
+			name:        "owner",
+			permissions: MemberPermissions{Member: Member{User: User{ID: ownerID}, GuildID: guildID}, OwnerID: ownerID, Roles: roles},
+			has:         PermissionsAll,
+		},
+		{
+			name:        "guild",
+			permissions: MemberPermissions{Member: member(nil, modRoleID), Roles: roles},
+			has:         PermissionSendMessages | PermissionManageMessages,
+			missing:     PermissionBanMembers,
+		},
+		{
+			name:        "administrator ignores overwrites & timeouts",
+			permissions: MemberPermissions{Member: member(&future, adminID), Roles: roles, Channel: text(RolePermissionOverwrite{RoleID: guildID, Deny: PermissionViewChannel}), Now: now},
+			has:         PermissionsAll,
+		},
+		{
+			name:        "role allow wins over role deny",
+			permissions: MemberPermissions{Member: member(nil, modRoleID), Roles: roles, Channel: text(RolePermissionOverwrite{RoleID: guildID, Deny: PermissionSendMessages}, RolePermissionOverwrite{RoleID: modRoleID, Allow: PermissionSendMessages})},
+			has:         PermissionSendMessages | PermissionEmbedLinks,
+		},
+		{
+			name:        "member deny wins over role allow",
+			permissions: MemberPermissions{Member: member(nil, modRoleID), Roles: roles, Channel: text(RolePermissionOverwrite{RoleID: modRoleID, Allow: PermissionAttachFiles}, MemberPermissionOverwrite{UserID: userID, Deny: PermissionAttachFiles})},
+			has:         PermissionSendMessages,
+			missing:     PermissionAttachFiles,
+		},
+		{
+			name:        "missing view channel denies everything",
+			permissions: MemberPermissions{Member: member(nil, modRoleID), Roles: roles, Channel: text(RolePermissionOverwrite{RoleID: guildID, Deny: PermissionViewChannel})},
+			missing:     PermissionManageMessages | PermissionSendMessages | PermissionReadMessageHistory,
+		},
+		{
+			name:        "missing send messages denies embed links",
+			permissions: MemberPermissions{Member: member(nil), Roles: roles, Channel: text(RolePermissionOverwrite{RoleID: guildID, Deny: PermissionSendMessages})},
+			has:         PermissionViewChannel,
+			missing:     PermissionEmbedLinks,
+		},
+		{
+			name:        "missing connect denies speak",
+			permissions: MemberPermissions{Member: member(nil), Roles: roles, Channel: GuildVoiceChannel{id: channelID, guildID: guildID, permissionOverwrites: []PermissionOverwrite{RolePermissionOverwrite{RoleID: guildID, Deny: PermissionVoiceConnect}}}},
+			has:         PermissionViewChannel,
+			missing:     PermissionVoiceSpeak,
+		},
+		{
+			name:        "thread uses parent overwrites",
+			permissions: MemberPermissions{Member: member(nil), Roles: roles, Channel: thread(ChannelTypeGuildPublicThread), Parent: text(RolePermissionOverwrite{RoleID: guildID, Deny: PermissionSendMessagesInThreads})},
+			has:         PermissionViewChannel | PermissionSendMessages,
+			missing:     PermissionSendMessagesInThreads | PermissionEmbedLinks,
+		},
+		{
+			name:        "private thread without membership",
+			permissions: MemberPermissions{Member: member(nil), Roles: roles, Channel: thread(ChannelTypeGuildPrivateThread), Parent: text()},
+			missing:     PermissionViewChannel,
+		},
+		{
+			name:        "private thread with membership",
+			permissions: MemberPermissions{Member: member(nil), Roles: roles, Channel: thread(ChannelTypeGuildPrivateThread), Parent: text(), ThreadMember: true},
+			has:         PermissionViewChannel | PermissionSendMessagesInThreads,
+		},
+		{
+			name:        "private thread with manage threads",
+			permissions: MemberPermissions{Member: member(nil, modRoleID), Roles: roles, Channel: thread(ChannelTypeGuildPrivateThread), Parent: text()},
+			has:         PermissionViewChannel | PermissionSendMessagesInThreads,
+		},
+		{
+			name:        "active timeout",
+			permissions: MemberPermissions{Member: member(&future, modRoleID), Roles: roles, Channel: text(), Now: now},
+			has:         PermissionViewChannel | PermissionReadMessageHistory,
+			missing:     PermissionSendMessages | PermissionManageMessages,
+		},
+		{
+			name:        "expired timeout",
+			permissions: MemberPermissions{Member: member(&past, modRoleID), Roles: roles, Channel: text(), Now: now},
+			has:         PermissionViewChannel | PermissionSendMessages | PermissionManageMessages,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions := tt.permissions.Permissions()
+			assert.True(t, permissions.Has(tt.has), "expected %s in %s", tt.has, permissions)
+			assert.Zero(t, permissions&tt.missing, "unexpected %s in %s", permissions&tt.missing, permissions)
+		})
+	}
+}
+
+func TestMemberPermissions_Explain(t *testing.T) {
+	guildID := snowflake.ID(1)
+	roleID := snowflake.ID(2)
+	userID := snowflake.ID(3)
+
+	explanation := MemberPermissions{
+		Member: Member{User: User{ID: userID}, GuildID: guildID, RoleIDs: []snowflake.ID{roleID}},
+		Roles: []Role{
+			{ID: guildID, Permissions: PermissionViewChannel | PermissionSendMessages | PermissionEmbedLinks},
+			{ID: roleID, Permissions: PermissionAttachFiles},
+		},
+		Channel: GuildTextChannel{id: 4, guildID: guildID, permissionOverwrites: PermissionOverwrites{
+			MemberPermissionOverwrite{UserID: userID, Deny: PermissionSendMessages},
+		}},
+	}.Explain()
+
+	assert.Equal(t, PermissionViewChannel, explanation.Permissions)
+	assert.Equal(t, PermissionExplanation{Permission: PermissionViewChannel, Allowed: true, Reason: PermissionReasonRole, ID: guildID}, explanation.Explanation(PermissionViewChannel))
+	assert.Equal(t, PermissionExplanation{Permission: PermissionSendMessages, Reason: PermissionReasonMemberOverwrite, ID: userID}, explanation.Explanation(PermissionSendMessages))
+	assert.Equal(t, PermissionExplanation{Permission: PermissionAttachFiles, Reason: PermissionReasonImplicit, Missing: PermissionSendMessages}, explanation.Explanation(PermissionAttachFiles))
+	assert.Equal(t, PermissionReasonNone, explanation.Explanation(PermissionBanMembers).Reason)
+	assert.Contains(t, explanation.String(), "Send Messages: denied by member overwrite 3\n")
+}
@@ -0,0 +1,392 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// OptionTagKey is the struct tag key used by ApplicationCommandOptions & SlashCommandInteractionData.Bind.
+//
+// A tag consists of comma separated flags & key=value pairs. Values containing commas can be wrapped in single quotes.
+//
+//	type BanOptions struct {
+//		User   discord.User `discord:"name=user,description=The user to ban,required"`
+//		Reason *string      `discord:"description='The reason, if any',max_length=512"`
+//		Days   int          `discord:"name=days,description=Days of messages to delete,min=0,max=7"`
+//	}
+//
+// Supported keys are:
+//
+//	name          the option name, defaults to the lowercase field name
+//	description   the option description
+//	required      marks the option as required
+//	autocomplete  enables autocomplete for string, int & float options
+//	min & max     the min & max value for int & float options
+//	min_length & max_length  the min & max length for string options
+//	choices       choices separated by |, each choice is name:value
+//	channel_types channel types separated by |
+//	type          overrides the option type, one of user, channel, role, mentionable for snowflake.ID fields
+//
+// Supported field types are string, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, bool, User, ResolvedMember, Role, ResolvedChannel, Attachment & snowflake.ID.
+// Options of unsigned int fields have a min of 0 unless min is set.
+// Pointer fields are nil when the option was not provided.
+const OptionTagKey = "discord"
+
+var (
+	// ErrInvalidBindTarget is returned by SlashCommandInteractionData.Bind when the target is not a non-nil pointer to a struct.
+	ErrInvalidBindTarget = errors.New("bind target must be a non-nil pointer to a struct")
+
+	optionFieldsCache sync.Map
+)
+
+var (
+	userType            = reflect.TypeOf(User{})
+	resolvedMemberType  = reflect.TypeOf(ResolvedMember{})
+	roleType            = reflect.TypeOf(Role{})
+	resolvedChannelType = reflect.TypeOf(ResolvedChannel{})
+	attachmentType      = reflect.TypeOf(Attachment{})
+	snowflakeType       = reflect.TypeOf(snowflake.ID(0))
+)
+
+// ApplicationCommandOptions generates the []ApplicationCommandOption of the given struct or pointer to a struct based on its OptionTagKey struct tags.
+// Required options are sorted before optional ones as Discord requires.
+func ApplicationCommandOptions(v any) ([]ApplicationCommandOption, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("options must be a struct or pointer to a struct, got %T", v)
+	}
+
+	fields, err := optionFields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]optionField, len(fields))
+	copy(sorted, fields)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].required && !sorted[j].required
+	})
+
+	options := make([]ApplicationCommandOption, len(sorted))
+	for i, field := range sorted {
+		options[i] = field.option
+	}
+	return options, nil
+}
+
+// Bind sets the fields of the given pointer to a struct to the values of the options with the same names, based on its OptionTagKey struct tags.
+// Users, members, roles, channels & attachments are taken from the Resolved data.
+// Fields of options which were not provided are left untouched.
+func (d SlashCommandInteractionData) Bind(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidBindTarget
+	}
+	rv = rv.Elem()
+
+	fields, err := optionFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		option, ok := d.Option(field.name)
+		if !ok {
+			continue
+		}
+		if _, err = d.bindOption(rv.FieldByIndex(field.index), option); err != nil {
+			return fmt.Errorf("failed to bind option %s: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+// bindOption sets the given field to the value of the option and returns whether it was set.
+func (d SlashCommandInteractionData) bindOption(fv reflect.Value, option SlashCommandOption) (bool, error) {
+	if fv.Kind() == reflect.Pointer {
+		value := reflect.New(fv.Type().Elem())
+		ok, err := d.bindOption(value.Elem(), option)
+		if ok {
+			fv.Set(value)
+		}
+		return ok, err
+	}
+
+	var (
+		resolved any
+		ok       bool
+	)
+	switch fv.Type() {
+	case userType:
+		resolved, ok = d.OptUser(option.Name)
+	case resolvedMemberType:
+		resolved, ok = d.OptMember(option.Name)
+	case roleType:
+		resolved, ok = d.OptRole(option.Name)
+	case resolvedChannelType:
+		resolved, ok = d.OptChannel(option.Name)
+	case attachmentType:
+		resolved, ok = d.OptAttachment(option.Name)
+	default:
+		if err := json.Unmarshal(option.Value, fv.Addr().Interface()); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if ok {
+		fv.Set(reflect.ValueOf(resolved))
+	}
+	return ok, nil
+}
+
+type optionField struct {
+	index    []int
+	name     string
+	required bool
+	option   ApplicationCommandOption
+}
+
+func optionFields(t reflect.Type) ([]optionField, error) {
+	if fields, ok := optionFieldsCache.Load(t); ok {
+		return fields.([]optionField), nil
+	}
+
+	var fields []optionField
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, ok := structField.Tag.Lookup(OptionTagKey)
+		if !ok || tag == "-" || !structField.IsExported() {
+			continue
+		}
+		field, err := parseOptionField(structField, tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid option field %s: %w", structField.Name, err)
+		}
+		fields = append(fields, field)
+	}
+
+	optionFieldsCache.Store(t, fields)
+	return fields, nil
+}
+
+func parseOptionField(structField reflect.StructField, tag string) (optionField, error) {
+	values, err := parseOptionTag(tag)
+	if err != nil {
+		return optionField{}, err
+	}
+
+	name := strings.ToLower(structField.Name)
+	if v, ok := values["name"]; ok {
+		name = v
+	}
+	_, required := values["required"]
+	_, autocomplete := values["autocomplete"]
+	description := values["description"]
+
+	fieldType := structField.Type
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	var option ApplicationCommandOption
+	switch {
+	case fieldType == userType, fieldType == resolvedMemberType:
+		option = ApplicationCommandOptionUser{Name: name, Description: description, Required: required}
+
+	case fieldType == roleType:
+		option = ApplicationCommandOptionRole{Name: name, Description: description, Required: required}
+
+	case fieldType == resolvedChannelType:
+		channelTypes, err := parseChannelTypes(values["channel_types"])
+		if err != nil {
+			return optionField{}, err
+		}
+		option = ApplicationCommandOptionChannel{Name: name, Description: description, Required: required, ChannelTypes: channelTypes}
+
+	case fieldType == attachmentType:
+		option = ApplicationCommandOptionAttachment{Name: name, Description: description, Required: required}
+
+	case fieldType == snowflakeType:
+		switch values["type"] {
+		case "user":
+			option = ApplicationCommandOptionUser{Name: name, Description: description, Required: required}
+		case "role":
+			option = ApplicationCommandOptionRole{Name: name, Description: description, Required: required}
+		case "channel":
+			channelTypes, err := parseChannelTypes(values["channel_types"])
+			if err != nil {
+				return optionField{}, err
+			}
+			option = ApplicationCommandOptionChannel{Name: name, Description: description, Required: required, ChannelTypes: channelTypes}
+		case "", "mentionable":
+			option = ApplicationCommandOptionMentionable{Name: name, Description: description, Required: required}
+		default:
+			return optionField{}, fmt.Errorf("unknown option type %s", values["type"])
+		}
+
+	case fieldType.Kind() == reflect.String:
+		o := ApplicationCommandOptionString{Name: name, Description: description, Required: required, Autocomplete: autocomplete}
+		if o.MinLength, err = parseOptionalInt(values, "min_length"); err != nil {
+			return optionField{}, err
+		}
+		if o.MaxLength, err = parseOptionalInt(values, "max_length"); err != nil {
+			return optionField{}, err
+		}
+		if err = parseChoices(values["choices"], func(name string, value string) error {
+			o.Choices = append(o.Choices, ApplicationCommandOptionChoiceString{Name: name, Value: value})
+			return nil
+		}); err != nil {
+			return optionField{}, err
+		}
+		option = o
+
+	case fieldType.Kind() >= reflect.Int && fieldType.Kind() <= reflect.Int64, fieldType.Kind() >= reflect.Uint && fieldType.Kind() <= reflect.Uint64:
+		o := ApplicationCommandOptionInt{Name: name, Description: description, Required: required, Autocomplete: autocomplete}
+		if o.MinValue, err = parseOptionalInt(values, "min"); err != nil {
+			return optionField{}, err
+		}
+		if o.MinValue == nil && fieldType.Kind() >= reflect.Uint {
+			o.MinValue = json.Ptr(0)
+		}
+		if o.MaxValue, err = parseOptionalInt(values, "max"); err != nil {
+			return optionField{}, err
+		}
+		if err = parseChoices(values["choices"], func(name string, value string) error {
+			v, err := strconv.Atoi(value)
+			o.Choices = append(o.Choices, ApplicationCommandOptionChoiceInt{Name: name, Value: v})
+			return err
+		}); err != nil {
+			return optionField{}, err
+		}
+		option = o
+
+	case fieldType.Kind() == reflect.Float32 || fieldType.Kind() == reflect.Float64:
+		o := ApplicationCommandOptionFloat{Name: name, Description: description, Required: required, Autocomplete: autocomplete}
+		if o.MinValue, err = parseOptionalFloat(values, "min"); err != nil {
+			return optionField{}, err
+		}
+		if o.MaxValue, err = parseOptionalFloat(values, "max"); err != nil {
+			return optionField{}, err
+		}
+		if err = parseChoices(values["choices"], func(name string, value string) error {
+			v, err := strconv.ParseFloat(value, 64)
+			o.Choices = append(o.Choices, ApplicationCommandOptionChoiceFloat{Name: name, Value: v})
+			return err
+		}); err != nil {
+			return optionField{}, err
+		}
+		option = o
+
+	case fieldType.Kind() == reflect.Bool:
+		option = ApplicationCommandOptionBool{Name: name, Description: description, Required: required}
+
+	default:
+		return optionField{}, fmt.Errorf("unsupported field type %s", structField.Type)
+	}
+
+	return optionField{
+		index:    structField.Index,
+		name:     name,
+		required: required,
+		option:   option,
+	}, nil
+}
+
+// parseOptionTag parses a tag like `name=user,description='The user, to ban',required` into its keys & values.
+func parseOptionTag(tag string) (map[string]string, error) {
+	values := make(map[string]string)
+	for tag != "" {
+		i := strings.IndexAny(tag, "=,")
+		if i == -1 {
+			values[strings.TrimSpace(tag)] = ""
+			break
+		}
+		key := strings.TrimSpace(tag[:i])
+		if tag[i] == ',' {
+			values[key] = ""
+			tag = tag[i+1:]
+			continue
+		}
+
+		var value string
+		tag = tag[i+1:]
+		if strings.HasPrefix(tag, "'") {
+			end := strings.Index(tag[1:], "'")
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated quote in value of %s", key)
+			}
+			value, tag = tag[1:end+1], strings.TrimPrefix(tag[end+2:], ",")
+		} else {
+			value, tag, _ = strings.Cut(tag, ",")
+		}
+		values[key] = value
+	}
+	delete(values, "")
+	return values, nil
+}
+
+func parseOptionalInt(values map[string]string, key string) (*int, error) {
+	value, ok := values[key]
+	if !ok {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return &v, nil
+}
+
+func parseOptionalFloat(values map[string]string, key string) (*float64, error) {
+	value, ok := values[key]
+	if !ok {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return &v, nil
+}
+
+func parseChoices(choices string, addChoice func(name string, value string) error) error {
+	if choices == "" {
+		return nil
+	}
+	for _, choice := range strings.Split(choices, "|") {
+		name, value, ok := strings.Cut(choice, ":")
+		if !ok {
+			value = name
+		}
+		if err := addChoice(name, value); err != nil {
+			return fmt.Errorf("invalid choice %s: %w", choice, err)
+		}
+	}
+	return nil
+}
+
+func parseChannelTypes(channelTypes string) ([]ChannelType, error) {
+	if channelTypes == "" {
+		return nil, nil
+	}
+	var types []ChannelType
+	for _, channelType := range strings.Split(channelTypes, "|") {
+		v, err := strconv.Atoi(channelType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel type %s: %w", channelType, err)
+		}
+		types = append(types, ChannelType(v))
+	}
+	return types, nil
+}
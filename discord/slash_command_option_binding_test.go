@@ -0,0 +1,80 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type banOptions struct {
+	Reason *string      `discord:"description='The reason, if any',max_length=512"`
+	User   User         `discord:"name=user,description=The user to ban,required"`
+	Days   int          `discord:"name=days,description=Days of messages to delete,min=0,max=7,choices=None:0|Week:7"`
+	Target snowflake.ID `discord:"name=target,type=role"`
+	Silent *bool        `discord:"name=silent"`
+	Ignore string
+}
+
+func TestApplicationCommandOptions(t *testing.T) {
+	options, err := ApplicationCommandOptions(banOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []ApplicationCommandOption{
+		ApplicationCommandOptionUser{Name: "user", Description: "The user to ban", Required: true},
+		ApplicationCommandOptionString{Name: "reason", Description: "The reason, if any", MaxLength: json.Ptr(512)},
+		ApplicationCommandOptionInt{Name: "days", Description: "Days of messages to delete", MinValue: json.Ptr(0), MaxValue: json.Ptr(7), Choices: []ApplicationCommandOptionChoiceInt{
+			{Name: "None", Value: 0},
+			{Name: "Week", Value: 7},
+		}},
+		ApplicationCommandOptionRole{Name: "target"},
+		ApplicationCommandOptionBool{Name: "silent"},
+	}, options)
+
+	options, err = ApplicationCommandOptions(struct {
+		Count uint8  `discord:"name=count"`
+		Limit uint64 `discord:"name=limit,min=10"`
+	}{})
+	assert.NoError(t, err)
+	assert.Equal(t, []ApplicationCommandOption{
+		ApplicationCommandOptionInt{Name: "count", MinValue: json.Ptr(0)},
+		ApplicationCommandOptionInt{Name: "limit", MinValue: json.Ptr(10)},
+	}, options)
+
+	_, err = ApplicationCommandOptions(struct {
+		Invalid []string `discord:"name=invalid"`
+	}{})
+	assert.Error(t, err)
+}
+
+func TestSlashCommandInteractionDataBind(t *testing.T) {
+	var data SlashCommandInteractionData
+	err := json.Unmarshal([]byte(`{
+		"id":"1","name":"ban",
+		"options":[
+			{"name":"user","type":6,"value":"10"},
+			{"name":"days","type":4,"value":7},
+			{"name":"target","type":8,"value":"20"},
+			{"name":"count","type":4,"value":3}
+		],
+		"resolved":{"users":{"10":{"id":"10","username":"test"}}}
+	}`), &data)
+	assert.NoError(t, err)
+
+	var options banOptions
+	assert.NoError(t, data.Bind(&options))
+	assert.Equal(t, snowflake.ID(10), options.User.ID)
+	assert.Equal(t, "test", options.User.Username)
+	assert.Equal(t, 7, options.Days)
+	assert.Equal(t, snowflake.ID(20), options.Target)
+	assert.Nil(t, options.Reason)
+	assert.Nil(t, options.Silent)
+
+	assert.ErrorIs(t, data.Bind(options), ErrInvalidBindTarget)
+
+	var countOptions struct {
+		Count uint `discord:"name=count"`
+	}
+	assert.NoError(t, data.Bind(&countOptions))
+	assert.Equal(t, uint(3), countOptions.Count)
+}
@@ -24,14 +24,26 @@
 //
 // Package httpserver is used to interact with the Discord outgoing webhooks for interactions.
 //
+// Voice
+//
+// Package voice is used to connect to Discord voice channels and send & receive opus audio.
+//
 // Events
 //
 // Package events provide high level events around the Discord Events.
 //
+// Handler
+//
+// Package handler provides a router for application commands, autocomplete, components & modals with middleware support.
+//
 // Rest
 //
 // Package rest is used to interact with the Discord REST API.
 //
+// Rest Proxy
+//
+// Package proxy provides an HTTP server which forwards Discord REST API requests with a shared bot token & central rate limiting.
+//
 // Webhook
 //
 // Package webhook provides a high level client interface for interacting with Discord webhooks.
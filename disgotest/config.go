@@ -0,0 +1,96 @@
+package disgotest
+
+import (
+	"time"
+
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Logger:            log.Default(),
+		Username:          "disgotest",
+		ShardCount:        1,
+		MaxConcurrency:    16,
+		HeartbeatInterval: 41250 * time.Millisecond,
+		ResumeBufferSize:  1000,
+	}
+}
+
+// Config lets you configure your Server instance.
+type Config struct {
+	Logger log.Logger
+	// ApplicationID is the ID of the application & bot user. A random ID is used if not set.
+	ApplicationID snowflake.ID
+	// Username is the username of the bot user.
+	Username string
+	// ShardCount is the recommended shard count returned by the gateway bot endpoint. Identifies with another shard count are closed with gateway.CloseEventCodeInvalidShard.
+	ShardCount int
+	// MaxConcurrency is the max concurrency returned by the gateway bot endpoint.
+	MaxConcurrency int
+	// HeartbeatInterval is the heartbeat interval sent in the hello payload.
+	HeartbeatInterval time.Duration
+	// ResumeBufferSize is the number of dispatched events kept per session to be replayed on resume.
+	ResumeBufferSize int
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithLogger lets you inject your own logger implementing log.Logger.
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithApplicationID sets the ID of the application & bot user.
+func WithApplicationID(applicationID snowflake.ID) ConfigOpt {
+	return func(config *Config) {
+		config.ApplicationID = applicationID
+	}
+}
+
+// WithUsername sets the username of the bot user.
+func WithUsername(username string) ConfigOpt {
+	return func(config *Config) {
+		config.Username = username
+	}
+}
+
+// WithShardCount sets the shard count the Server expects.
+func WithShardCount(shardCount int) ConfigOpt {
+	return func(config *Config) {
+		config.ShardCount = shardCount
+	}
+}
+
+// WithMaxConcurrency sets the max concurrency returned by the gateway bot endpoint.
+func WithMaxConcurrency(maxConcurrency int) ConfigOpt {
+	return func(config *Config) {
+		config.MaxConcurrency = maxConcurrency
+	}
+}
+
+// WithHeartbeatInterval sets the heartbeat interval sent in the hello payload.
+func WithHeartbeatInterval(interval time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.HeartbeatInterval = interval
+	}
+}
+
+// WithResumeBufferSize sets the number of dispatched events kept per session to be replayed on resume.
+func WithResumeBufferSize(size int) ConfigOpt {
+	return func(config *Config) {
+		config.ResumeBufferSize = size
+	}
+}
@@ -0,0 +1,264 @@
+// Package disgotest provides a fake Discord server running in-process to test a bot.Client end to end without a connection to Discord.
+//
+// The Server speaks enough of the REST API (messages, channels, guilds, members, application commands & interactions) and the gateway protocol (hello, identify, ready, dispatch, resume & heartbeats)
+// to run a bot.Client with a gateway.Gateway or sharding.ShardManager against it.
+// Events can be injected with Server.Dispatch & REST calls can be asserted with Server.Requests & Server.WaitForRequest:
+//
+//	server := disgotest.New()
+//	defer server.Close()
+//
+//	client, _ := disgo.New(server.Token(), append(server.ClientConfigOpts(), bot.WithDefaultGateway())...)
+//	_ = client.OpenGateway(ctx)
+//	_ = server.WaitForShard(ctx, 0)
+//
+//	_ = server.Dispatch(0, gateway.EventTypeMessageCreate, message)
+//	rq, _ := server.WaitForRequest(ctx, rest.CreateMessage)
+//
+// Command & component handlers can also be tested without a Server by creating the interactions & events directly:
+//
+//	interaction := disgotest.NewSlashCommandInteraction(disgotest.SlashCommand{Name: "ping"}, disgotest.WithInteractionGuild(guildID))
+//	e, recorder := disgotest.NewApplicationCommandInteractionCreate(nil, interaction)
+//	handler(e)
+//	response, _ := recorder.Response()
+package disgotest
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	// ErrShardNotFound is returned when no session was identified for the shard.
+	ErrShardNotFound = errors.New("shard not found")
+	// ErrShardNotConnected is returned when the shard has a session but is currently not connected.
+	ErrShardNotConnected = errors.New("shard not connected")
+)
+
+var _ Server = (*serverImpl)(nil)
+
+// Token returns a fake bot token for the given application ID which can be parsed by the bot.Client.
+func Token(applicationID snowflake.ID) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(applicationID.String())) + ".disgotest.token"
+}
+
+// New starts a new Server with the ConfigOpt(s) applied.
+func New(opts ...ConfigOpt) Server {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	s := &serverImpl{
+		config:   *config,
+		changed:  make(chan struct{}),
+		handlers: map[*rest.Endpoint]HandlerFunc{},
+		sessions: map[int]*session{},
+		state:    newState(),
+	}
+	if s.config.ApplicationID == 0 {
+		s.config.ApplicationID = newID()
+	}
+	s.token = Token(s.config.ApplicationID)
+	s.httpServer = httptest.NewServer(s)
+	return s
+}
+
+// Server is a fake Discord server. Create one with New.
+type Server interface {
+	// URL returns the base URL of the Server.
+	URL() string
+	// GatewayURL returns the websocket URL of the gateway.
+	GatewayURL() string
+	// Token returns the bot token the Server accepts.
+	Token() string
+	// SelfUser returns the bot user.
+	SelfUser() discord.OAuth2User
+	// ClientConfigOpts returns the bot.ConfigOpt(s) which point the bot.Client to the Server.
+	ClientConfigOpts() []bot.ConfigOpt
+	// Close shuts down the Server & closes all gateway connections.
+	Close()
+
+	// Handle overrides the handling of the given rest.Endpoint. This can be used to add endpoints the Server doesn't know or to simulate errors.
+	Handle(endpoint *rest.Endpoint, handler HandlerFunc)
+	// Requests returns all REST requests received so far.
+	Requests() []Request
+	// RequestsTo returns all REST requests received so far to the given rest.Endpoint.
+	RequestsTo(endpoint *rest.Endpoint) []Request
+	// WaitForRequest waits for the next or already received not yet waited for REST request to the given rest.Endpoint.
+	WaitForRequest(ctx context.Context, endpoint *rest.Endpoint) (Request, error)
+
+	// AddGuild adds the discord.GatewayGuild with its channels, roles & members. It is sent to the shard of the guild on identify or right away if the shard is ready.
+	AddGuild(guild discord.GatewayGuild) error
+	// Guild returns the discord.Guild with the given ID.
+	Guild(guildID snowflake.ID) (discord.Guild, bool)
+	// Channel returns the discord.Channel with the given ID.
+	Channel(channelID snowflake.ID) (discord.Channel, bool)
+	// Member returns the discord.Member of the guild with the given user ID.
+	Member(guildID snowflake.ID, userID snowflake.ID) (discord.Member, bool)
+	// Messages returns all messages of the channel ordered from oldest to newest.
+	Messages(channelID snowflake.ID) []discord.Message
+	// Commands returns the application commands of the guild or the global commands if guildID is 0.
+	Commands(guildID snowflake.ID) []discord.ApplicationCommand
+
+	// Dispatch sends the event to the shard. If the shard is currently disconnected the event is replayed on resume.
+	Dispatch(shardID int, eventType gateway.EventType, data any) error
+	// ShardForGuild returns the shard ID of the guild.
+	ShardForGuild(guildID snowflake.ID) int
+	// WaitForShard waits until the shard is connected & received the ready or resumed event.
+	WaitForShard(ctx context.Context, shardID int) error
+	// Shard returns the current state of the shard.
+	Shard(shardID int) (ShardState, bool)
+	// GatewayCommands returns all commands except heartbeats, identifies & resumes the shard sent so far, for example presence updates or member requests.
+	GatewayCommands(shardID int) []GatewayCommand
+	// Reconnect tells the shard to reconnect & resume.
+	Reconnect(shardID int) error
+	// InvalidateSession tells the shard its session is invalid.
+	InvalidateSession(shardID int, resumable bool) error
+	// Disconnect closes the connection of the shard with the given close code.
+	Disconnect(shardID int, code gateway.CloseEventCode) error
+}
+
+type serverImpl struct {
+	config     Config
+	token      string
+	httpServer *httptest.Server
+
+	mu sync.Mutex
+	// changed is closed & replaced whenever requests or sessions changed
+	changed  chan struct{}
+	handlers map[*rest.Endpoint]HandlerFunc
+	requests []Request
+	// waited is the number of requests per endpoint already returned by WaitForRequest
+	waited   map[*rest.Endpoint]int
+	sessions map[int]*session
+	state    *state
+}
+
+func (s *serverImpl) URL() string {
+	return s.httpServer.URL
+}
+
+func (s *serverImpl) GatewayURL() string {
+	return "ws" + strings.TrimPrefix(s.httpServer.URL, "http") + gatewayPath
+}
+
+func (s *serverImpl) Token() string {
+	return s.token
+}
+
+func (s *serverImpl) SelfUser() discord.OAuth2User {
+	return discord.OAuth2User{
+		User: discord.User{
+			ID:            s.config.ApplicationID,
+			Username:      s.config.Username,
+			Discriminator: "0000",
+			Bot:           true,
+		},
+	}
+}
+
+func (s *serverImpl) ClientConfigOpts() []bot.ConfigOpt {
+	return []bot.ConfigOpt{
+		bot.WithLogger(s.config.Logger),
+		bot.WithRestClientConfigOpts(rest.WithURL(s.URL() + apiPath)),
+	}
+}
+
+func (s *serverImpl) Close() {
+	s.mu.Lock()
+	for _, ss := range s.sessions {
+		if ss.conn != nil {
+			ss.conn.close(int(gateway.CloseEventCodeUnknownError), "server closed")
+		}
+	}
+	s.mu.Unlock()
+	s.httpServer.Close()
+}
+
+func (s *serverImpl) Handle(endpoint *rest.Endpoint, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[endpoint] = handler
+}
+
+func (s *serverImpl) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *serverImpl) RequestsTo(endpoint *rest.Endpoint) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requestsTo(endpoint)
+}
+
+func (s *serverImpl) requestsTo(endpoint *rest.Endpoint) []Request {
+	var requests []Request
+	for _, rq := range s.requests {
+		if rq.Endpoint == endpoint {
+			requests = append(requests, rq)
+		}
+	}
+	return requests
+}
+
+func (s *serverImpl) WaitForRequest(ctx context.Context, endpoint *rest.Endpoint) (Request, error) {
+	var rq Request
+	err := s.waitFor(ctx, func() bool {
+		requests := s.requestsTo(endpoint)
+		if s.waited == nil {
+			s.waited = map[*rest.Endpoint]int{}
+		}
+		if len(requests) <= s.waited[endpoint] {
+			return false
+		}
+		rq = requests[s.waited[endpoint]]
+		s.waited[endpoint]++
+		return true
+	})
+	return rq, err
+}
+
+// waitFor calls condition with the lock held until it returns true or the context.Context is done.
+func (s *serverImpl) waitFor(ctx context.Context, condition func() bool) error {
+	for {
+		s.mu.Lock()
+		if condition() {
+			s.mu.Unlock()
+			return nil
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// notify wakes up all waitFor calls. The lock must be held.
+func (s *serverImpl) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// increment is the increment part of the snowflake.ID(s) created by newID.
+var increment uint32
+
+// newID returns a new unique snowflake.ID.
+func newID() snowflake.ID {
+	return snowflake.New(time.Now()) | snowflake.ID(atomic.AddUint32(&increment, 1)&0xfff)
+}
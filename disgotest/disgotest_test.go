@@ -0,0 +1,119 @@
+package disgotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := New()
+	defer server.Close()
+
+	guildID := snowflake.New(time.Now())
+	channelID := guildID + 1
+	var channel discord.GuildTextChannel
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"`+channelID.String()+`","type":0,"name":"general"}`), &channel))
+	require.NoError(t, server.AddGuild(discord.GatewayGuild{
+		RestGuild: discord.RestGuild{Guild: discord.Guild{ID: guildID, Name: "test"}},
+		Channels: []discord.GuildChannel{
+			channel,
+		},
+	}))
+
+	guildReady := make(chan struct{}, 1)
+	client, err := disgo.New(server.Token(), append(server.ClientConfigOpts(),
+		bot.WithDefaultGateway(),
+		bot.WithCacheConfigOpts(cache.WithCacheFlags(cache.FlagChannels)),
+		bot.WithEventListenerFunc(func(e *events.GuildReady) {
+			guildReady <- struct{}{}
+		}),
+		bot.WithEventListenerFunc(func(e *events.MessageCreate) {
+			if e.Message.Author.Bot {
+				return
+			}
+			_, _ = e.Client().Rest().CreateMessage(e.ChannelID, discord.MessageCreate{Content: "pong"})
+		}),
+	)...)
+	require.NoError(t, err)
+	defer client.Close(context.Background())
+
+	require.NoError(t, client.OpenGateway(ctx))
+	require.NoError(t, server.WaitForShard(ctx, 0))
+	select {
+	case <-guildReady:
+	case <-ctx.Done():
+		t.Fatal("guild was not received")
+	}
+	_, ok := client.Caches().Channels().Get(channelID)
+	assert.True(t, ok)
+
+	require.NoError(t, server.Dispatch(0, gateway.EventTypeMessageCreate, discord.Message{
+		ID:        snowflake.New(time.Now()),
+		ChannelID: channelID,
+		GuildID:   &guildID,
+		Content:   "ping",
+		Author:    discord.User{ID: 1, Username: "user"},
+	}))
+	rq, err := server.WaitForRequest(ctx, rest.CreateMessage)
+	require.NoError(t, err)
+	assert.Equal(t, channelID.String(), rq.Params["channel.id"])
+
+	messages := server.Messages(channelID)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "pong", messages[0].Content)
+
+	// events dispatched while the shard reconnects are replayed on resume
+	require.NoError(t, server.Disconnect(0, gateway.CloseEventCodeUnknownError))
+	require.NoError(t, server.Dispatch(0, gateway.EventTypeMessageCreate, discord.Message{
+		ID:        snowflake.New(time.Now()),
+		ChannelID: channelID,
+		GuildID:   &guildID,
+		Content:   "ping",
+		Author:    discord.User{ID: 1, Username: "user"},
+	}))
+	_, err = server.WaitForRequest(ctx, rest.CreateMessage)
+	require.NoError(t, err)
+
+	shard, ok := server.Shard(0)
+	require.True(t, ok)
+	assert.Equal(t, 1, shard.Identifies)
+	assert.Equal(t, 1, shard.Resumes)
+	assert.Len(t, server.Messages(channelID), 2)
+}
+
+func TestServerSharding(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := New(WithShardCount(2))
+	defer server.Close()
+
+	client, err := disgo.New(server.Token(), append(server.ClientConfigOpts(),
+		bot.WithDefaultShardManager(),
+	)...)
+	require.NoError(t, err)
+	defer client.Close(context.Background())
+
+	require.NoError(t, client.OpenShardManager(ctx))
+	for shardID := 0; shardID < 2; shardID++ {
+		require.NoError(t, server.WaitForShard(ctx, shardID))
+		shard, _ := server.Shard(shardID)
+		assert.Equal(t, 2, shard.ShardCount)
+	}
+}
@@ -0,0 +1,443 @@
+package disgotest
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/internal/etf"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/gorilla/websocket"
+)
+
+const gatewayPath = "/gateway"
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// GatewayCommand is a command a shard sent to the Server which isn't a heartbeat, identify or resume.
+type GatewayCommand struct {
+	Op   gateway.Opcode
+	Data json.RawMessage
+}
+
+// ShardState is a snapshot of the session of a shard.
+type ShardState struct {
+	ShardID    int
+	ShardCount int
+	SessionID  string
+	// Sequence is the sequence of the last dispatched event.
+	Sequence int
+	// Connected is true if the shard currently has a gateway connection.
+	Connected bool
+	// Ready is true if the shard received the ready or resumed event on its current connection.
+	Ready      bool
+	Identifies int
+	Resumes    int
+	Heartbeats int
+}
+
+type dispatchedEvent struct {
+	seq       int
+	eventType gateway.EventType
+	data      json.RawMessage
+}
+
+// session is the gateway session of a shard. It outlives connections so events can be replayed on resume.
+type session struct {
+	id         string
+	shardID    int
+	shardCount int
+	seq        int
+	events     []dispatchedEvent
+	conn       *conn
+	ready      bool
+	identifies int
+	resumes    int
+	heartbeats int
+	commands   []GatewayCommand
+}
+
+func (ss *session) shardState() ShardState {
+	return ShardState{
+		ShardID:    ss.shardID,
+		ShardCount: ss.shardCount,
+		SessionID:  ss.id,
+		Sequence:   ss.seq,
+		Connected:  ss.conn != nil,
+		Ready:      ss.conn != nil && ss.ready,
+		Identifies: ss.identifies,
+		Resumes:    ss.resumes,
+		Heartbeats: ss.heartbeats,
+	}
+}
+
+// conn is a single gateway connection. All writes must happen with the lock of the Server held.
+type conn struct {
+	ws       *websocket.Conn
+	encoding gateway.Encoding
+	// zlibWriter is the deflate context of the connection if zlib-stream transport compression is used
+	zlibWriter *zlib.Writer
+	zlibBuffer bytes.Buffer
+}
+
+type payload struct {
+	Op gateway.Opcode    `json:"op"`
+	S  int               `json:"s,omitempty"`
+	T  gateway.EventType `json:"t,omitempty"`
+	D  any               `json:"d"`
+}
+
+func (c *conn) send(p payload) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	messageType := websocket.TextMessage
+	if c.encoding == gateway.EncodingETF {
+		if data, err = etf.FromJSON(data); err != nil {
+			return err
+		}
+		messageType = websocket.BinaryMessage
+	}
+	if c.zlibWriter != nil {
+		c.zlibBuffer.Reset()
+		if _, err = c.zlibWriter.Write(data); err != nil {
+			return err
+		}
+		if err = c.zlibWriter.Flush(); err != nil {
+			return err
+		}
+		data = c.zlibBuffer.Bytes()
+		messageType = websocket.BinaryMessage
+	}
+	return c.ws.WriteMessage(messageType, data)
+}
+
+func (c *conn) close(code int, text string) {
+	_ = c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+	_ = c.ws.Close()
+}
+
+func (s *serverImpl) serveGateway(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.config.Logger.Error("failed to upgrade gateway connection: ", err)
+		return
+	}
+
+	c := &conn{
+		ws:       ws,
+		encoding: gateway.Encoding(r.URL.Query().Get("encoding")),
+	}
+	if c.encoding == "" {
+		c.encoding = gateway.EncodingJSON
+	}
+	if r.URL.Query().Get("compress") == "zlib-stream" {
+		c.zlibWriter = zlib.NewWriter(&c.zlibBuffer)
+	}
+
+	s.mu.Lock()
+	err = c.send(payload{Op: gateway.OpcodeHello, D: gateway.MessageDataHello{HeartbeatInterval: int(s.config.HeartbeatInterval.Milliseconds())}})
+	s.mu.Unlock()
+	if err != nil {
+		_ = ws.Close()
+		return
+	}
+	s.listen(c)
+}
+
+// listen reads the commands of the connection until it is closed.
+func (s *serverImpl) listen(c *conn) {
+	var ss *session
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_ = c.ws.Close()
+		if ss != nil && ss.conn == c {
+			ss.conn = nil
+			ss.ready = false
+			s.notify()
+		}
+	}()
+
+	for {
+		mt, data, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if mt == websocket.BinaryMessage && c.encoding == gateway.EncodingETF {
+			if data, err = etf.ToJSON(data); err != nil {
+				s.mu.Lock()
+				c.close(int(gateway.CloseEventCodeDecodeError), "error while decoding payload")
+				s.mu.Unlock()
+				return
+			}
+		}
+
+		var message struct {
+			Op gateway.Opcode  `json:"op"`
+			D  json.RawMessage `json:"d"`
+		}
+		if err = json.Unmarshal(data, &message); err != nil {
+			s.mu.Lock()
+			c.close(int(gateway.CloseEventCodeDecodeError), "error while decoding payload")
+			s.mu.Unlock()
+			return
+		}
+
+		s.mu.Lock()
+		switch message.Op {
+		case gateway.OpcodeHeartbeat:
+			if ss != nil {
+				ss.heartbeats++
+			}
+			err = c.send(payload{Op: gateway.OpcodeHeartbeatACK})
+
+		case gateway.OpcodeIdentify:
+			ss, err = s.identify(c, message.D)
+
+		case gateway.OpcodeResume:
+			ss, err = s.resume(c, message.D)
+
+		default:
+			if ss == nil {
+				c.close(int(gateway.CloseEventCodeNotAuthenticated), "not authenticated")
+				err = websocket.ErrCloseSent
+				break
+			}
+			ss.commands = append(ss.commands, GatewayCommand{Op: message.Op, Data: message.D})
+		}
+		s.notify()
+		s.mu.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// identify creates a new session for the shard & sends the ready & guild create events. The lock must be held.
+func (s *serverImpl) identify(c *conn, data json.RawMessage) (*session, error) {
+	var identify gateway.MessageDataIdentify
+	if err := json.Unmarshal(data, &identify); err != nil {
+		c.close(int(gateway.CloseEventCodeDecodeError), "error while decoding payload")
+		return nil, websocket.ErrCloseSent
+	}
+	if identify.Token != s.token {
+		c.close(int(gateway.CloseEventCodeAuthenticationFailed), "authentication failed")
+		return nil, websocket.ErrCloseSent
+	}
+	shard := [2]int{0, 1}
+	if identify.Shard != nil {
+		shard = *identify.Shard
+	}
+	if shard[1] != s.config.ShardCount || shard[0] < 0 || shard[0] >= shard[1] {
+		c.close(int(gateway.CloseEventCodeInvalidShard), "invalid shard")
+		return nil, websocket.ErrCloseSent
+	}
+
+	ss, ok := s.sessions[shard[0]]
+	if !ok {
+		ss = &session{shardID: shard[0]}
+		s.sessions[shard[0]] = ss
+	}
+	if ss.conn != nil && ss.conn != c {
+		ss.conn.close(int(gateway.CloseEventCodeSessionTimedOut), "session replaced")
+	}
+	ss.id = newID().String()
+	ss.shardCount = shard[1]
+	ss.seq = 0
+	ss.events = nil
+	ss.conn = c
+	ss.ready = true
+	ss.identifies++
+
+	var guildIDs []snowflake.ID
+	for guildID := range s.state.guilds {
+		if s.shardForGuild(guildID) == ss.shardID {
+			guildIDs = append(guildIDs, guildID)
+		}
+	}
+	guilds := make([]discord.UnavailableGuild, len(guildIDs))
+	for i, guildID := range guildIDs {
+		guilds[i] = discord.UnavailableGuild{ID: guildID, Unavailable: true}
+	}
+
+	if err := s.dispatch(ss, gateway.EventTypeReady, gateway.EventReady{
+		Version:          gateway.Version,
+		User:             s.SelfUser(),
+		Guilds:           guilds,
+		SessionID:        ss.id,
+		ResumeGatewayURL: s.GatewayURL(),
+		Shard:            shard[:],
+		Application:      discord.PartialApplication{ID: s.config.ApplicationID},
+	}); err != nil {
+		return ss, err
+	}
+	for _, guildID := range guildIDs {
+		if err := s.dispatch(ss, gateway.EventTypeGuildCreate, s.state.gatewayGuild(guildID)); err != nil {
+			return ss, err
+		}
+	}
+	return ss, nil
+}
+
+// resume replays the events the shard missed & sends the resumed event. The lock must be held.
+func (s *serverImpl) resume(c *conn, data json.RawMessage) (*session, error) {
+	var resume gateway.MessageDataResume
+	if err := json.Unmarshal(data, &resume); err != nil {
+		c.close(int(gateway.CloseEventCodeDecodeError), "error while decoding payload")
+		return nil, websocket.ErrCloseSent
+	}
+	if resume.Token != s.token {
+		c.close(int(gateway.CloseEventCodeAuthenticationFailed), "authentication failed")
+		return nil, websocket.ErrCloseSent
+	}
+
+	var ss *session
+	for _, sss := range s.sessions {
+		if sss.id == resume.SessionID {
+			ss = sss
+			break
+		}
+	}
+	// the session is unknown or the missed events are no longer buffered
+	if ss == nil || resume.Seq > ss.seq || (resume.Seq < ss.seq && (len(ss.events) == 0 || ss.events[0].seq > resume.Seq+1)) {
+		return nil, c.send(payload{Op: gateway.OpcodeInvalidSession, D: false})
+	}
+
+	if ss.conn != nil && ss.conn != c {
+		ss.conn.close(int(gateway.CloseEventCodeSessionTimedOut), "session resumed")
+	}
+	ss.conn = c
+	ss.resumes++
+	for _, event := range ss.events {
+		if event.seq <= resume.Seq {
+			continue
+		}
+		if err := c.send(payload{Op: gateway.OpcodeDispatch, S: event.seq, T: event.eventType, D: event.data}); err != nil {
+			return ss, err
+		}
+	}
+	ss.ready = true
+	return ss, s.dispatch(ss, gateway.EventTypeResumed, nil)
+}
+
+// dispatch buffers the event & sends it if the shard is connected. The lock must be held.
+func (s *serverImpl) dispatch(ss *session, eventType gateway.EventType, data any) error {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	ss.seq++
+	ss.events = append(ss.events, dispatchedEvent{seq: ss.seq, eventType: eventType, data: rawData})
+	if len(ss.events) > s.config.ResumeBufferSize {
+		ss.events = ss.events[len(ss.events)-s.config.ResumeBufferSize:]
+	}
+	if ss.conn == nil || !ss.ready {
+		return nil
+	}
+	return ss.conn.send(payload{Op: gateway.OpcodeDispatch, S: ss.seq, T: eventType, D: json.RawMessage(rawData)})
+}
+
+func (s *serverImpl) shardForGuild(guildID snowflake.ID) int {
+	return int((uint64(guildID) >> 22) % uint64(s.config.ShardCount))
+}
+
+func (s *serverImpl) ShardForGuild(guildID snowflake.ID) int {
+	return s.shardForGuild(guildID)
+}
+
+func (s *serverImpl) Dispatch(shardID int, eventType gateway.EventType, data any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.sessions[shardID]
+	if !ok {
+		return ErrShardNotFound
+	}
+	return s.dispatch(ss, eventType, data)
+}
+
+func (s *serverImpl) WaitForShard(ctx context.Context, shardID int) error {
+	return s.waitFor(ctx, func() bool {
+		ss, ok := s.sessions[shardID]
+		return ok && ss.conn != nil && ss.ready
+	})
+}
+
+func (s *serverImpl) Shard(shardID int) (ShardState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.sessions[shardID]
+	if !ok {
+		return ShardState{}, false
+	}
+	return ss.shardState(), true
+}
+
+func (s *serverImpl) GatewayCommands(shardID int) []GatewayCommand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.sessions[shardID]
+	if !ok {
+		return nil
+	}
+	return append([]GatewayCommand(nil), ss.commands...)
+}
+
+// connectedSession returns the session of the shard if it is connected. The lock must be held.
+func (s *serverImpl) connectedSession(shardID int) (*session, error) {
+	ss, ok := s.sessions[shardID]
+	if !ok {
+		return nil, ErrShardNotFound
+	}
+	if ss.conn == nil {
+		return nil, ErrShardNotConnected
+	}
+	return ss, nil
+}
+
+func (s *serverImpl) Reconnect(shardID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, err := s.connectedSession(shardID)
+	if err != nil {
+		return err
+	}
+	return ss.conn.send(payload{Op: gateway.OpcodeReconnect})
+}
+
+func (s *serverImpl) InvalidateSession(shardID int, resumable bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, err := s.connectedSession(shardID)
+	if err != nil {
+		return err
+	}
+	if !resumable {
+		ss.id = ""
+	}
+	return ss.conn.send(payload{Op: gateway.OpcodeInvalidSession, D: resumable})
+}
+
+func (s *serverImpl) Disconnect(shardID int, code gateway.CloseEventCode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, err := s.connectedSession(shardID)
+	if err != nil {
+		return err
+	}
+	ss.conn.close(int(code), "disconnected by server")
+	ss.conn = nil
+	ss.ready = false
+	s.notify()
+	return nil
+}
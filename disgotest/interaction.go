@@ -0,0 +1,333 @@
+package disgotest
+
+import (
+	"sort"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SlashCommand is the data of a slash command interaction.
+type SlashCommand struct {
+	// ID is the ID of the command. A random ID is used if not set.
+	ID   snowflake.ID
+	Name string
+	// GuildID is set for guild commands.
+	GuildID             *snowflake.ID
+	SubCommandGroupName string
+	SubCommandName      string
+	Options             []discord.SlashCommandOption
+	Resolved            discord.SlashCommandResolved
+}
+
+// UserCommand is the data of a user command interaction.
+type UserCommand struct {
+	// ID is the ID of the command. A random ID is used if not set.
+	ID   snowflake.ID
+	Name string
+	// GuildID is set for guild commands.
+	GuildID *snowflake.ID
+	Target  discord.User
+	// TargetMember is the member of the Target if the command was used in a guild.
+	TargetMember *discord.ResolvedMember
+}
+
+// MessageCommand is the data of a message command interaction.
+type MessageCommand struct {
+	// ID is the ID of the command. A random ID is used if not set.
+	ID   snowflake.ID
+	Name string
+	// GuildID is set for guild commands.
+	GuildID *snowflake.ID
+	Target  discord.Message
+}
+
+// AutocompleteCommand is the data of an autocomplete interaction.
+type AutocompleteCommand struct {
+	// ID is the ID of the command. A random ID is used if not set.
+	ID   snowflake.ID
+	Name string
+	// GuildID is set for guild commands.
+	GuildID             *snowflake.ID
+	SubCommandGroupName string
+	SubCommandName      string
+	Options             []discord.AutocompleteOption
+}
+
+// Component is the data of a component interaction.
+type Component struct {
+	Type     discord.ComponentType
+	CustomID string
+	// Values are the selected values of select menus. The values of user, role, mentionable & channel select menus are IDs.
+	Values []string
+	// Resolved are the selected entities of user, role, mentionable & channel select menus.
+	Resolved discord.SlashCommandResolved
+	// Message is the message the component is attached to.
+	Message discord.Message
+}
+
+// ModalSubmit is the data of a modal submit interaction.
+type ModalSubmit struct {
+	CustomID string
+	// Values are the values of the text inputs by their custom ID.
+	Values map[string]string
+}
+
+// Option returns a discord.SlashCommandOption with the value marshalled to JSON.
+func Option(name string, optionType discord.ApplicationCommandOptionType, value any) discord.SlashCommandOption {
+	return discord.SlashCommandOption{
+		Name:  name,
+		Type:  optionType,
+		Value: mustMarshal(value),
+	}
+}
+
+// AutocompleteOption returns a discord.AutocompleteOption with the value marshalled to JSON.
+func AutocompleteOption(name string, optionType discord.ApplicationCommandOptionType, value any, focused bool) discord.AutocompleteOption {
+	return discord.AutocompleteOption{
+		Name:    name,
+		Type:    optionType,
+		Value:   mustMarshal(value),
+		Focused: focused,
+	}
+}
+
+// NewSlashCommandInteraction returns a discord.ApplicationCommandInteraction with discord.SlashCommandInteractionData as Discord would send it.
+func NewSlashCommandInteraction(command SlashCommand, opts ...InteractionConfigOpt) discord.ApplicationCommandInteraction {
+	options := make([]commandOption, len(command.Options))
+	for i, option := range command.Options {
+		options[i] = commandOption{Name: option.Name, Type: option.Type, Value: option.Value}
+	}
+
+	var interaction discord.ApplicationCommandInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeApplicationCommand, commandData{
+		ID:       idOrNew(command.ID),
+		Name:     command.Name,
+		Type:     discord.ApplicationCommandTypeSlash,
+		GuildID:  command.GuildID,
+		Resolved: command.Resolved,
+		Options:  wrapOptions(command.SubCommandGroupName, command.SubCommandName, options),
+	}, nil, &interaction, opts)
+	return interaction
+}
+
+// NewUserCommandInteraction returns a discord.ApplicationCommandInteraction with discord.UserCommandInteractionData as Discord would send it.
+func NewUserCommandInteraction(command UserCommand, opts ...InteractionConfigOpt) discord.ApplicationCommandInteraction {
+	resolved := discord.UserCommandResolved{
+		Users: map[snowflake.ID]discord.User{command.Target.ID: command.Target},
+	}
+	if command.TargetMember != nil {
+		resolved.Members = map[snowflake.ID]discord.ResolvedMember{command.Target.ID: *command.TargetMember}
+	}
+
+	var interaction discord.ApplicationCommandInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeApplicationCommand, commandData{
+		ID:       idOrNew(command.ID),
+		Name:     command.Name,
+		Type:     discord.ApplicationCommandTypeUser,
+		GuildID:  command.GuildID,
+		Resolved: resolved,
+		TargetID: command.Target.ID,
+	}, nil, &interaction, opts)
+	return interaction
+}
+
+// NewMessageCommandInteraction returns a discord.ApplicationCommandInteraction with discord.MessageCommandInteractionData as Discord would send it.
+func NewMessageCommandInteraction(command MessageCommand, opts ...InteractionConfigOpt) discord.ApplicationCommandInteraction {
+	var interaction discord.ApplicationCommandInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeApplicationCommand, commandData{
+		ID:      idOrNew(command.ID),
+		Name:    command.Name,
+		Type:    discord.ApplicationCommandTypeMessage,
+		GuildID: command.GuildID,
+		Resolved: discord.MessageCommandResolved{
+			Messages: map[snowflake.ID]discord.Message{command.Target.ID: command.Target},
+		},
+		TargetID: command.Target.ID,
+	}, nil, &interaction, opts)
+	return interaction
+}
+
+// NewAutocompleteInteraction returns a discord.AutocompleteInteraction as Discord would send it.
+func NewAutocompleteInteraction(command AutocompleteCommand, opts ...InteractionConfigOpt) discord.AutocompleteInteraction {
+	options := make([]commandOption, len(command.Options))
+	for i, option := range command.Options {
+		options[i] = commandOption{Name: option.Name, Type: option.Type, Value: option.Value, Focused: option.Focused}
+	}
+
+	var interaction discord.AutocompleteInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeAutocomplete, commandData{
+		ID:      idOrNew(command.ID),
+		Name:    command.Name,
+		Type:    discord.ApplicationCommandTypeSlash,
+		GuildID: command.GuildID,
+		Options: wrapOptions(command.SubCommandGroupName, command.SubCommandName, options),
+	}, nil, &interaction, opts)
+	return interaction
+}
+
+// NewComponentInteraction returns a discord.ComponentInteraction as Discord would send it.
+func NewComponentInteraction(component Component, opts ...InteractionConfigOpt) discord.ComponentInteraction {
+	data := componentData{
+		ComponentType: component.Type,
+		CustomID:      component.CustomID,
+	}
+	if component.Type != discord.ComponentTypeButton {
+		data.Values = component.Values
+		if data.Values == nil {
+			data.Values = []string{}
+		}
+		if component.Type != discord.ComponentTypeStringSelectMenu {
+			data.Resolved = &component.Resolved
+		}
+	}
+
+	var interaction discord.ComponentInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeComponent, data, &component.Message, &interaction, opts)
+	return interaction
+}
+
+// NewModalSubmitInteraction returns a discord.ModalSubmitInteraction with a text input in its own action row for every value as Discord would send it.
+func NewModalSubmitInteraction(modal ModalSubmit, opts ...InteractionConfigOpt) discord.ModalSubmitInteraction {
+	customIDs := make([]string, 0, len(modal.Values))
+	for customID := range modal.Values {
+		customIDs = append(customIDs, customID)
+	}
+	sort.Strings(customIDs)
+
+	components := make([]discord.ContainerComponent, len(customIDs))
+	for i, customID := range customIDs {
+		components[i] = discord.ActionRowComponent{discord.TextInputComponent{
+			CustomID: customID,
+			Value:    modal.Values[customID],
+		}}
+	}
+
+	var interaction discord.ModalSubmitInteraction
+	mustUnmarshalInteraction(discord.InteractionTypeModalSubmit, modalSubmitData{
+		CustomID:   modal.CustomID,
+		Components: components,
+	}, nil, &interaction, opts)
+	return interaction
+}
+
+type rawInteraction struct {
+	ID             snowflake.ID            `json:"id"`
+	Type           discord.InteractionType `json:"type"`
+	ApplicationID  snowflake.ID            `json:"application_id"`
+	Token          string                  `json:"token"`
+	Version        int                     `json:"version"`
+	GuildID        *snowflake.ID           `json:"guild_id,omitempty"`
+	ChannelID      snowflake.ID            `json:"channel_id"`
+	Locale         discord.Locale          `json:"locale"`
+	GuildLocale    *discord.Locale         `json:"guild_locale,omitempty"`
+	Member         *discord.ResolvedMember `json:"member,omitempty"`
+	User           *discord.User           `json:"user,omitempty"`
+	AppPermissions *discord.Permissions    `json:"app_permissions,omitempty"`
+	Data           any                     `json:"data"`
+	Message        *discord.Message        `json:"message,omitempty"`
+}
+
+type commandData struct {
+	ID       snowflake.ID                   `json:"id"`
+	Name     string                         `json:"name"`
+	Type     discord.ApplicationCommandType `json:"type"`
+	GuildID  *snowflake.ID                  `json:"guild_id,omitempty"`
+	Resolved any                            `json:"resolved,omitempty"`
+	Options  []commandOption                `json:"options,omitempty"`
+	TargetID snowflake.ID                   `json:"target_id,omitempty"`
+}
+
+type commandOption struct {
+	Name    string                               `json:"name"`
+	Type    discord.ApplicationCommandOptionType `json:"type"`
+	Value   json.RawMessage                      `json:"value,omitempty"`
+	Focused bool                                 `json:"focused,omitempty"`
+	Options []commandOption                      `json:"options,omitempty"`
+}
+
+type componentData struct {
+	ComponentType discord.ComponentType         `json:"component_type"`
+	CustomID      string                        `json:"custom_id"`
+	Values        []string                      `json:"values,omitempty"`
+	Resolved      *discord.SlashCommandResolved `json:"resolved,omitempty"`
+}
+
+type modalSubmitData struct {
+	CustomID   string                       `json:"custom_id"`
+	Components []discord.ContainerComponent `json:"components"`
+}
+
+// wrapOptions nests the options in the sub command & sub command group if set.
+func wrapOptions(subCommandGroupName string, subCommandName string, options []commandOption) []commandOption {
+	if subCommandName != "" {
+		options = []commandOption{{Name: subCommandName, Type: discord.ApplicationCommandOptionTypeSubCommand, Options: options}}
+	}
+	if subCommandGroupName != "" {
+		options = []commandOption{{Name: subCommandGroupName, Type: discord.ApplicationCommandOptionTypeSubCommandGroup, Options: options}}
+	}
+	return options
+}
+
+// mustUnmarshalInteraction builds the interaction payload & unmarshals it into v, so the interaction goes through the same code as interactions received from Discord.
+// Invalid data is a bug in the test, so it panics like httptest.NewRequest does.
+func mustUnmarshalInteraction(interactionType discord.InteractionType, data any, message *discord.Message, v any, opts []InteractionConfigOpt) {
+	config := DefaultInteractionConfig()
+	config.Apply(opts)
+
+	interaction := rawInteraction{
+		ID:             idOrNew(config.ID),
+		Type:           interactionType,
+		ApplicationID:  idOrNew(config.ApplicationID),
+		Token:          config.Token,
+		Version:        config.Version,
+		GuildID:        config.GuildID,
+		ChannelID:      idOrNew(config.ChannelID),
+		Locale:         config.Locale,
+		GuildLocale:    config.GuildLocale,
+		AppPermissions: config.AppPermissions,
+		Data:           data,
+		Message:        message,
+	}
+	if interaction.Token == "" {
+		interaction.Token = "interaction." + interaction.ID.String() + ".token"
+	}
+
+	user := config.User
+	if user.ID == 0 {
+		user.ID = newID()
+	}
+	if config.GuildID == nil {
+		interaction.User = &user
+	} else if config.Member != nil {
+		interaction.Member = config.Member
+	} else {
+		interaction.Member = &discord.ResolvedMember{
+			Member: discord.Member{
+				User:    user,
+				GuildID: *config.GuildID,
+			},
+			Permissions: discord.PermissionsAll,
+		}
+	}
+
+	if err := json.Unmarshal(mustMarshal(interaction), v); err != nil {
+		panic("disgotest: invalid interaction: " + err.Error())
+	}
+}
+
+func mustMarshal(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic("disgotest: failed to marshal: " + err.Error())
+	}
+	return data
+}
+
+func idOrNew(id snowflake.ID) snowflake.ID {
+	if id == 0 {
+		return newID()
+	}
+	return id
+}
@@ -0,0 +1,117 @@
+package disgotest
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultInteractionConfig returns an InteractionConfig for an interaction sent by a user in a DM.
+func DefaultInteractionConfig() *InteractionConfig {
+	return &InteractionConfig{
+		Version: 1,
+		User: discord.User{
+			Username:      "user",
+			Discriminator: "0000",
+		},
+		Locale: discord.LocaleEnglishUS,
+	}
+}
+
+// InteractionConfig holds the fields all interactions have in common. IDs & the token are generated if not set.
+type InteractionConfig struct {
+	ID            snowflake.ID
+	ApplicationID snowflake.ID
+	Token         string
+	Version       int
+	// GuildID is set for interactions in a guild. Those are sent with the Member instead of the User.
+	GuildID   *snowflake.ID
+	ChannelID snowflake.ID
+	User      discord.User
+	// Member is the member of the User in the guild. If not set, a member of the User with all permissions is used.
+	Member         *discord.ResolvedMember
+	Locale         discord.Locale
+	GuildLocale    *discord.Locale
+	AppPermissions *discord.Permissions
+}
+
+// InteractionConfigOpt is a type alias for a function that takes an InteractionConfig and is used to configure the created interaction.
+type InteractionConfigOpt func(config *InteractionConfig)
+
+// Apply applies the given InteractionConfigOpt(s) to the InteractionConfig
+func (c *InteractionConfig) Apply(opts []InteractionConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithInteractionID sets the ID of the interaction.
+func WithInteractionID(id snowflake.ID) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.ID = id
+	}
+}
+
+// WithInteractionApplicationID sets the ID of the application the interaction is for.
+func WithInteractionApplicationID(applicationID snowflake.ID) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.ApplicationID = applicationID
+	}
+}
+
+// WithInteractionToken sets the token of the interaction.
+func WithInteractionToken(token string) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.Token = token
+	}
+}
+
+// WithInteractionGuild sets the guild the interaction was sent in.
+func WithInteractionGuild(guildID snowflake.ID) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.GuildID = &guildID
+	}
+}
+
+// WithInteractionChannelID sets the channel the interaction was sent in.
+func WithInteractionChannelID(channelID snowflake.ID) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.ChannelID = channelID
+	}
+}
+
+// WithInteractionUser sets the user who sent the interaction.
+func WithInteractionUser(user discord.User) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.User = user
+	}
+}
+
+// WithInteractionMember sets the guild & member who sent the interaction.
+func WithInteractionMember(member discord.ResolvedMember) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.GuildID = &member.GuildID
+		config.User = member.User
+		config.Member = &member
+	}
+}
+
+// WithInteractionLocale sets the locale of the user who sent the interaction.
+func WithInteractionLocale(locale discord.Locale) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.Locale = locale
+	}
+}
+
+// WithInteractionGuildLocale sets the preferred locale of the guild the interaction was sent in.
+func WithInteractionGuildLocale(locale discord.Locale) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.GuildLocale = &locale
+	}
+}
+
+// WithInteractionAppPermissions sets the permissions the application has in the channel the interaction was sent in.
+func WithInteractionAppPermissions(permissions discord.Permissions) InteractionConfigOpt {
+	return func(config *InteractionConfig) {
+		config.AppPermissions = &permissions
+	}
+}
@@ -0,0 +1,101 @@
+package disgotest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/rest"
+)
+
+// InteractionResponse is a response recorded by an InteractionRecorder.
+type InteractionResponse struct {
+	Type discord.InteractionResponseType
+	Data discord.InteractionResponseData
+}
+
+// InteractionRecorder records the responses to an interaction. Like Discord, it only accepts one response & returns rest.ErrInteractionAlreadyAcknowledged for every further one.
+type InteractionRecorder struct {
+	mu        sync.Mutex
+	responses []InteractionResponse
+}
+
+// Respond records the response. It can be used as events.InteractionResponderFunc.
+func (r *InteractionRecorder) Respond(responseType discord.InteractionResponseType, data discord.InteractionResponseData, _ ...rest.RequestOpt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses = append(r.responses, InteractionResponse{Type: responseType, Data: data})
+	if len(r.responses) > 1 {
+		return rest.ErrInteractionAlreadyAcknowledged
+	}
+	return nil
+}
+
+// Response returns the first response to the interaction.
+func (r *InteractionRecorder) Response() (InteractionResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.responses) == 0 {
+		return InteractionResponse{}, false
+	}
+	return r.responses[0], true
+}
+
+// Responses returns all responses to the interaction including the rejected ones.
+func (r *InteractionRecorder) Responses() []InteractionResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]InteractionResponse(nil), r.responses...)
+}
+
+// NewInteractionCreate returns an events.InteractionCreate for the interaction which records its responses. The bot.Client can be nil if the handler doesn't use it.
+func NewInteractionCreate(client bot.Client, interaction discord.Interaction) (*events.InteractionCreate, *InteractionRecorder) {
+	recorder := &InteractionRecorder{}
+	return &events.InteractionCreate{
+		GenericEvent: events.NewGenericEvent(context.Background(), client, 0, 0),
+		Interaction:  interaction,
+		Respond:      recorder.Respond,
+	}, recorder
+}
+
+// NewApplicationCommandInteractionCreate returns an events.ApplicationCommandInteractionCreate for the interaction which records its responses. The bot.Client can be nil if the handler doesn't use it.
+func NewApplicationCommandInteractionCreate(client bot.Client, interaction discord.ApplicationCommandInteraction) (*events.ApplicationCommandInteractionCreate, *InteractionRecorder) {
+	recorder := &InteractionRecorder{}
+	return &events.ApplicationCommandInteractionCreate{
+		GenericEvent:                  events.NewGenericEvent(context.Background(), client, 0, 0),
+		ApplicationCommandInteraction: interaction,
+		Respond:                       recorder.Respond,
+	}, recorder
+}
+
+// NewComponentInteractionCreate returns an events.ComponentInteractionCreate for the interaction which records its responses. The bot.Client can be nil if the handler doesn't use it.
+func NewComponentInteractionCreate(client bot.Client, interaction discord.ComponentInteraction) (*events.ComponentInteractionCreate, *InteractionRecorder) {
+	recorder := &InteractionRecorder{}
+	return &events.ComponentInteractionCreate{
+		GenericEvent:         events.NewGenericEvent(context.Background(), client, 0, 0),
+		ComponentInteraction: interaction,
+		Respond:              recorder.Respond,
+	}, recorder
+}
+
+// NewAutocompleteInteractionCreate returns an events.AutocompleteInteractionCreate for the interaction which records its responses. The bot.Client can be nil if the handler doesn't use it.
+func NewAutocompleteInteractionCreate(client bot.Client, interaction discord.AutocompleteInteraction) (*events.AutocompleteInteractionCreate, *InteractionRecorder) {
+	recorder := &InteractionRecorder{}
+	return &events.AutocompleteInteractionCreate{
+		GenericEvent:            events.NewGenericEvent(context.Background(), client, 0, 0),
+		AutocompleteInteraction: interaction,
+		Respond:                 recorder.Respond,
+	}, recorder
+}
+
+// NewModalSubmitInteractionCreate returns an events.ModalSubmitInteractionCreate for the interaction which records its responses. The bot.Client can be nil if the handler doesn't use it.
+func NewModalSubmitInteractionCreate(client bot.Client, interaction discord.ModalSubmitInteraction) (*events.ModalSubmitInteractionCreate, *InteractionRecorder) {
+	recorder := &InteractionRecorder{}
+	return &events.ModalSubmitInteractionCreate{
+		GenericEvent:           events.NewGenericEvent(context.Background(), client, 0, 0),
+		ModalSubmitInteraction: interaction,
+		Respond:                recorder.Respond,
+	}, recorder
+}
@@ -0,0 +1,90 @@
+package disgotest
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashCommandInteraction(t *testing.T) {
+	target := discord.User{ID: 2, Username: "target"}
+	member := discord.ResolvedMember{
+		Member:      discord.Member{User: discord.User{ID: 1, Username: "user"}, GuildID: 3},
+		Permissions: discord.PermissionSendMessages,
+	}
+
+	interaction := NewSlashCommandInteraction(SlashCommand{
+		Name:           "settings",
+		SubCommandName: "set",
+		Options: []discord.SlashCommandOption{
+			Option("name", discord.ApplicationCommandOptionTypeString, "value"),
+			Option("user", discord.ApplicationCommandOptionTypeUser, target.ID),
+		},
+		Resolved: discord.SlashCommandResolved{
+			Users: map[snowflake.ID]discord.User{target.ID: target},
+		},
+	}, WithInteractionMember(member), WithInteractionLocale(discord.LocaleGerman), WithInteractionAppPermissions(discord.PermissionEmbedLinks))
+
+	data := interaction.SlashCommandInteractionData()
+	assert.Equal(t, "settings", data.CommandName())
+	require.NotNil(t, data.SubCommandName)
+	assert.Equal(t, "set", *data.SubCommandName)
+	assert.Equal(t, "value", data.String("name"))
+	assert.Equal(t, target, data.User("user"))
+
+	require.NotNil(t, interaction.GuildID())
+	assert.Equal(t, snowflake.ID(3), *interaction.GuildID())
+	assert.Equal(t, member.User, interaction.User())
+	assert.Equal(t, discord.LocaleGerman, interaction.Locale())
+	assert.Equal(t, discord.PermissionEmbedLinks, *interaction.AppPermissions())
+	assert.NotEmpty(t, interaction.Token())
+
+	e, recorder := NewApplicationCommandInteractionCreate(nil, interaction)
+	assert.NoError(t, e.CreateMessage(discord.MessageCreate{Content: "saved"}))
+	assert.ErrorIs(t, e.CreateMessage(discord.MessageCreate{Content: "saved"}), rest.ErrInteractionAlreadyAcknowledged)
+
+	response, ok := recorder.Response()
+	require.True(t, ok)
+	assert.Equal(t, discord.InteractionResponseTypeCreateMessage, response.Type)
+	assert.Equal(t, discord.MessageCreate{Content: "saved"}, response.Data)
+	assert.Len(t, recorder.Responses(), 2)
+}
+
+func TestComponentInteractions(t *testing.T) {
+	user := discord.User{ID: 2, Username: "selected"}
+	interaction := NewComponentInteraction(Component{
+		Type:     discord.ComponentTypeUserSelectMenu,
+		CustomID: "users",
+		Values:   []string{user.ID.String()},
+		Resolved: discord.SlashCommandResolved{
+			Users: map[snowflake.ID]discord.User{user.ID: user},
+		},
+		Message: discord.Message{ID: 4, Content: "pick a user"},
+	})
+	assert.Equal(t, "users", interaction.Data.CustomID())
+	assert.Equal(t, []discord.User{user}, interaction.UserSelectMenuInteractionData().Users())
+	assert.Equal(t, "pick a user", interaction.Message.Content)
+
+	modal := NewModalSubmitInteraction(ModalSubmit{
+		CustomID: "feedback",
+		Values:   map[string]string{"title": "Hello", "body": "World"},
+	})
+	assert.Equal(t, "feedback", modal.Data.CustomID)
+	assert.Equal(t, "Hello", modal.Data.Text("title"))
+	assert.Equal(t, "World", modal.Data.Text("body"))
+
+	autocomplete := NewAutocompleteInteraction(AutocompleteCommand{
+		Name: "search",
+		Options: []discord.AutocompleteOption{
+			AutocompleteOption("query", discord.ApplicationCommandOptionTypeString, "disg", true),
+		},
+	})
+	option, ok := autocomplete.Data.Option("query")
+	require.True(t, ok)
+	assert.True(t, option.Focused)
+	assert.Equal(t, "disg", autocomplete.Data.String("query"))
+}
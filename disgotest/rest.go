@@ -0,0 +1,862 @@
+package disgotest
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const apiPath = "/api"
+
+var apiVersionPath = regexp.MustCompile(`^/api/v\d+`)
+
+// HandlerFunc handles a REST Request to the Server.
+type HandlerFunc func(w http.ResponseWriter, r Request)
+
+// Request is a REST request received by the Server.
+type Request struct {
+	// Endpoint is the rest.Endpoint the request matched or nil if the Server doesn't handle it.
+	Endpoint *rest.Endpoint
+	Method   string
+	// Path is the path without the base path of the API.
+	Path string
+	// Params are the url params of the Endpoint like "channel.id".
+	Params map[string]string
+	Query  url.Values
+	Header http.Header
+	// Body is the JSON body. For multipart requests it is the payload_json part.
+	Body []byte
+	// Files are the names of the files of multipart requests.
+	Files []string
+}
+
+// ID returns the url param with the given name as snowflake.ID.
+func (r Request) ID(name string) snowflake.ID {
+	id, _ := snowflake.Parse(r.Params[name])
+	return id
+}
+
+// Unmarshal unmarshals the Body into v.
+func (r Request) Unmarshal(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// WriteJSON writes v as JSON response with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// WriteError writes a Discord JSON error response with the given status & rest.JSONErrorCode.
+func WriteError(w http.ResponseWriter, status int, code rest.JSONErrorCode) {
+	message := code.Error()
+	if code == 0 {
+		message = strconv.Itoa(status) + ": " + http.StatusText(status)
+	}
+	WriteJSON(w, status, struct {
+		Code    rest.JSONErrorCode `json:"code"`
+		Message string             `json:"message"`
+	}{Code: code, Message: message})
+}
+
+func (s *serverImpl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == gatewayPath {
+		s.serveGateway(w, r)
+		return
+	}
+
+	prefix := apiVersionPath.FindString(r.URL.Path)
+	if prefix == "" {
+		WriteError(w, http.StatusNotFound, 0)
+		return
+	}
+
+	rq := Request{
+		Method: r.Method,
+		Path:   strings.TrimPrefix(r.URL.Path, prefix),
+		Query:  r.URL.Query(),
+		Header: r.Header,
+	}
+	if err := readBody(r, &rq); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+
+	s.mu.Lock()
+	var handler HandlerFunc
+	rq.Endpoint, rq.Params, handler = s.route(rq.Method, rq.Path)
+	s.requests = append(s.requests, rq)
+	s.notify()
+	s.mu.Unlock()
+
+	if handler == nil {
+		WriteError(w, http.StatusNotFound, 0)
+		return
+	}
+	if rq.Endpoint.BotAuth && r.Header.Get("Authorization") != "Bot "+s.token {
+		WriteError(w, http.StatusUnauthorized, rest.ErrUnauthorized)
+		return
+	}
+	handler(w, rq)
+}
+
+// readBody reads the JSON body or the payload_json & file names of multipart requests.
+func readBody(r *http.Request, rq *Request) error {
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		body, err := io.ReadAll(r.Body)
+		rq.Body = body
+		return err
+	}
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if part.FormName() == "payload_json" {
+			if rq.Body, err = io.ReadAll(part); err != nil {
+				return err
+			}
+			continue
+		}
+		rq.Files = append(rq.Files, part.FileName())
+	}
+}
+
+// route returns the rest.Endpoint which matches the request with the most static path segments, its url params & handler. The lock must be held.
+func (s *serverImpl) route(method string, path string) (*rest.Endpoint, map[string]string, HandlerFunc) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var (
+		best       *rest.Endpoint
+		bestParams map[string]string
+		bestScore  = -1
+	)
+	match := func(endpoint *rest.Endpoint) {
+		if endpoint.Method != method {
+			return
+		}
+		routeSegments := strings.Split(strings.Trim(endpoint.Route, "/"), "/")
+		if len(routeSegments) != len(segments) {
+			return
+		}
+		params := map[string]string{}
+		score := 0
+		for i, routeSegment := range routeSegments {
+			if strings.HasPrefix(routeSegment, "{") && strings.HasSuffix(routeSegment, "}") {
+				params[routeSegment[1:len(routeSegment)-1]] = segments[i]
+				continue
+			}
+			if routeSegment != segments[i] {
+				return
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestParams, bestScore = endpoint, params, score
+		}
+	}
+	for endpoint := range s.handlers {
+		match(endpoint)
+	}
+	for endpoint := range s.defaultHandlers() {
+		match(endpoint)
+	}
+	if best == nil {
+		return nil, nil, nil
+	}
+	if handler, ok := s.handlers[best]; ok {
+		return best, bestParams, handler
+	}
+	return best, bestParams, s.defaultHandlers()[best]
+}
+
+func (s *serverImpl) defaultHandlers() map[*rest.Endpoint]HandlerFunc {
+	return map[*rest.Endpoint]HandlerFunc{
+		rest.GetGateway:     s.getGateway,
+		rest.GetGatewayBot:  s.getGatewayBot,
+		rest.GetCurrentUser: s.getCurrentUser,
+
+		rest.GetGuild:           s.getGuild,
+		rest.GetGuildChannels:   s.getGuildChannels,
+		rest.CreateGuildChannel: s.createGuildChannel,
+		rest.GetChannel:         s.getChannel,
+		rest.UpdateChannel:      s.updateChannel,
+		rest.DeleteChannel:      s.deleteChannel,
+
+		rest.GetMessages:   s.getMessages,
+		rest.GetMessage:    s.getMessage,
+		rest.CreateMessage: s.createMessage,
+		rest.UpdateMessage: s.updateMessage,
+		rest.DeleteMessage: s.deleteMessage,
+
+		rest.GetMembers:       s.getMembers,
+		rest.GetMember:        s.getMember,
+		rest.UpdateMember:     s.updateMember,
+		rest.RemoveMember:     s.removeMember,
+		rest.AddMemberRole:    s.addMemberRole,
+		rest.RemoveMemberRole: s.removeMemberRole,
+
+		rest.GetGlobalCommands:   s.getCommands,
+		rest.GetGlobalCommand:    s.getCommand,
+		rest.CreateGlobalCommand: s.createCommand,
+		rest.SetGlobalCommands:   s.setCommands,
+		rest.UpdateGlobalCommand: s.updateCommand,
+		rest.DeleteGlobalCommand: s.deleteCommand,
+		rest.GetGuildCommands:    s.getCommands,
+		rest.GetGuildCommand:     s.getCommand,
+		rest.CreateGuildCommand:  s.createCommand,
+		rest.SetGuildCommands:    s.setCommands,
+		rest.UpdateGuildCommand:  s.updateCommand,
+		rest.DeleteGuildCommand:  s.deleteCommand,
+
+		rest.CreateInteractionResponse: s.createInteractionResponse,
+		rest.GetInteractionResponse:    s.getInteractionResponse,
+		rest.UpdateInteractionResponse: s.updateInteractionResponse,
+		rest.DeleteInteractionResponse: s.deleteInteractionResponse,
+		rest.CreateFollowupMessage:     s.createFollowupMessage,
+		rest.UpdateFollowupMessage:     s.updateFollowupMessage,
+		rest.DeleteFollowupMessage:     s.deleteFollowupMessage,
+	}
+}
+
+func (s *serverImpl) getGateway(w http.ResponseWriter, _ Request) {
+	WriteJSON(w, http.StatusOK, discord.Gateway{URL: s.GatewayURL()})
+}
+
+func (s *serverImpl) getGatewayBot(w http.ResponseWriter, _ Request) {
+	WriteJSON(w, http.StatusOK, discord.GatewayBot{
+		URL:    s.GatewayURL(),
+		Shards: s.config.ShardCount,
+		SessionStartLimit: discord.SessionStartLimit{
+			Total:          1000,
+			Remaining:      1000,
+			MaxConcurrency: s.config.MaxConcurrency,
+		},
+	})
+}
+
+func (s *serverImpl) getCurrentUser(w http.ResponseWriter, _ Request) {
+	WriteJSON(w, http.StatusOK, s.SelfUser())
+}
+
+func (s *serverImpl) getGuild(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guild, ok := s.state.guilds[r.ID("guild.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownGuild)
+		return
+	}
+	WriteJSON(w, http.StatusOK, guild)
+}
+
+func (s *serverImpl) getGuildChannels(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	if _, ok := s.state.guilds[guildID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownGuild)
+		return
+	}
+	channels := s.state.sortedChannels(guildID)
+	if channels == nil {
+		channels = []object{}
+	}
+	WriteJSON(w, http.StatusOK, channels)
+}
+
+func (s *serverImpl) createGuildChannel(w http.ResponseWriter, r Request) {
+	var channel object
+	if err := r.Unmarshal(&channel); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	if _, ok := s.state.guilds[guildID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownGuild)
+		return
+	}
+	id := newID()
+	channel["id"] = id.String()
+	channel["guild_id"] = guildID.String()
+	if _, ok := channel["type"]; !ok {
+		channel["type"] = discord.ChannelTypeGuildText
+	}
+	s.state.channels[id] = channel
+	WriteJSON(w, http.StatusCreated, channel)
+}
+
+func (s *serverImpl) getChannel(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channel, ok := s.state.channels[r.ID("channel.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownChannel)
+		return
+	}
+	WriteJSON(w, http.StatusOK, channel)
+}
+
+func (s *serverImpl) updateChannel(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channel, ok := s.state.channels[r.ID("channel.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownChannel)
+		return
+	}
+	channel.merge(update)
+	WriteJSON(w, http.StatusOK, channel)
+}
+
+func (s *serverImpl) deleteChannel(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	channel, ok := s.state.channels[channelID]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownChannel)
+		return
+	}
+	delete(s.state.channels, channelID)
+	delete(s.state.messages, channelID)
+	WriteJSON(w, http.StatusOK, channel)
+}
+
+// newMessage returns a new message sent by the bot user from the message create payload. The lock must be held.
+func (s *serverImpl) newMessage(channelID snowflake.ID, body []byte) (object, error) {
+	message := object{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &message); err != nil {
+			return nil, err
+		}
+	}
+	author, _ := toObject(s.SelfUser().User)
+	message.merge(object{
+		"id":          newID().String(),
+		"channel_id":  channelID.String(),
+		"author":      author,
+		"type":        discord.MessageTypeDefault,
+		"timestamp":   time.Now().UTC().Format(time.RFC3339Nano),
+		"attachments": []any{},
+	})
+	if channel, ok := s.state.channels[channelID]; ok && channel["guild_id"] != nil {
+		message["guild_id"] = channel["guild_id"]
+	}
+	for _, key := range []string{"content", "embeds", "components", "mentions", "mention_roles"} {
+		if _, ok := message[key]; !ok {
+			if key == "content" {
+				message[key] = ""
+				continue
+			}
+			message[key] = []any{}
+		}
+	}
+	return message, nil
+}
+
+func (s *serverImpl) findMessage(channelID snowflake.ID, messageID snowflake.ID) (int, bool) {
+	for i, message := range s.state.messages[channelID] {
+		if message.id("id") == messageID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (s *serverImpl) getMessages(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	if _, ok := s.state.channels[channelID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownChannel)
+		return
+	}
+	limit := 50
+	if l, err := strconv.Atoi(r.Query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	before, _ := snowflake.Parse(r.Query.Get("before"))
+	after, _ := snowflake.Parse(r.Query.Get("after"))
+
+	// messages are returned from newest to oldest
+	messages := make([]object, 0, limit)
+	all := s.state.messages[channelID]
+	for i := len(all) - 1; i >= 0; i-- {
+		id := all[i].id("id")
+		if (before != 0 && id >= before) || (after != 0 && id <= after) {
+			continue
+		}
+		messages = append(messages, all[i])
+	}
+	if len(messages) > limit {
+		if after != 0 {
+			messages = messages[len(messages)-limit:]
+		} else {
+			messages = messages[:limit]
+		}
+	}
+	WriteJSON(w, http.StatusOK, messages)
+}
+
+func (s *serverImpl) getMessage(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	i, ok := s.findMessage(channelID, r.ID("message.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	WriteJSON(w, http.StatusOK, s.state.messages[channelID][i])
+}
+
+func (s *serverImpl) createMessage(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	if _, ok := s.state.channels[channelID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownChannel)
+		return
+	}
+	message, err := s.newMessage(channelID, r.Body)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.state.messages[channelID] = append(s.state.messages[channelID], message)
+	WriteJSON(w, http.StatusOK, message)
+}
+
+func (s *serverImpl) updateMessage(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	i, ok := s.findMessage(channelID, r.ID("message.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	message := s.state.messages[channelID][i]
+	message.merge(update)
+	message["edited_timestamp"] = time.Now().UTC().Format(time.RFC3339Nano)
+	WriteJSON(w, http.StatusOK, message)
+}
+
+func (s *serverImpl) deleteMessage(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID := r.ID("channel.id")
+	i, ok := s.findMessage(channelID, r.ID("message.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	messages := s.state.messages[channelID]
+	s.state.messages[channelID] = append(messages[:i:i], messages[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *serverImpl) getMembers(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	if _, ok := s.state.guilds[guildID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownGuild)
+		return
+	}
+	limit := 1
+	if l, err := strconv.Atoi(r.Query.Get("limit")); err == nil && l > 0 && l <= 1000 {
+		limit = l
+	}
+	after, _ := snowflake.Parse(r.Query.Get("after"))
+
+	members := make([]object, 0, limit)
+	for _, member := range s.state.sortedMembers(guildID) {
+		if memberUserID(member) <= after {
+			continue
+		}
+		if len(members) == limit {
+			break
+		}
+		members = append(members, member)
+	}
+	WriteJSON(w, http.StatusOK, members)
+}
+
+func (s *serverImpl) getMember(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	member, ok := s.state.members[r.ID("guild.id")][r.ID("user.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMember)
+		return
+	}
+	WriteJSON(w, http.StatusOK, member)
+}
+
+func (s *serverImpl) updateMember(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	member, ok := s.state.members[r.ID("guild.id")][r.ID("user.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMember)
+		return
+	}
+	member.merge(update)
+	WriteJSON(w, http.StatusOK, member)
+}
+
+func (s *serverImpl) removeMember(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID, userID := r.ID("guild.id"), r.ID("user.id")
+	if _, ok := s.state.members[guildID][userID]; !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMember)
+		return
+	}
+	delete(s.state.members[guildID], userID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *serverImpl) addMemberRole(w http.ResponseWriter, r Request) {
+	s.updateMemberRoles(w, r, func(roleIDs []any, roleID string) []any {
+		for _, id := range roleIDs {
+			if id == roleID {
+				return roleIDs
+			}
+		}
+		return append(roleIDs, roleID)
+	})
+}
+
+func (s *serverImpl) removeMemberRole(w http.ResponseWriter, r Request) {
+	s.updateMemberRoles(w, r, func(roleIDs []any, roleID string) []any {
+		filtered := make([]any, 0, len(roleIDs))
+		for _, id := range roleIDs {
+			if id != roleID {
+				filtered = append(filtered, id)
+			}
+		}
+		return filtered
+	})
+}
+
+func (s *serverImpl) updateMemberRoles(w http.ResponseWriter, r Request, update func(roleIDs []any, roleID string) []any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	member, ok := s.state.members[r.ID("guild.id")][r.ID("user.id")]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMember)
+		return
+	}
+	roleIDs, _ := member["roles"].([]any)
+	member["roles"] = update(roleIDs, r.Params["role.id"])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newCommand returns the command with the ID & application ID set. The lock must be held.
+func (s *serverImpl) newCommand(r Request, command object) object {
+	command["id"] = newID().String()
+	command["application_id"] = s.config.ApplicationID.String()
+	command["version"] = newID().String()
+	if guildID := r.ID("guild.id"); guildID != 0 {
+		command["guild_id"] = guildID.String()
+	}
+	if _, ok := command["type"]; !ok {
+		command["type"] = discord.ApplicationCommandTypeSlash
+	}
+	return command
+}
+
+func (s *serverImpl) findCommand(guildID snowflake.ID, commandID snowflake.ID) (int, bool) {
+	for i, command := range s.state.commands[guildID] {
+		if command.id("id") == commandID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (s *serverImpl) getCommands(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	commands := s.state.commands[r.ID("guild.id")]
+	if commands == nil {
+		commands = []object{}
+	}
+	WriteJSON(w, http.StatusOK, commands)
+}
+
+func (s *serverImpl) getCommand(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	i, ok := s.findCommand(guildID, r.ID("command.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownApplicationCommand)
+		return
+	}
+	WriteJSON(w, http.StatusOK, s.state.commands[guildID][i])
+}
+
+func (s *serverImpl) createCommand(w http.ResponseWriter, r Request) {
+	var command object
+	if err := r.Unmarshal(&command); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	// commands with the same name & type are overwritten
+	for i, existing := range s.state.commands[guildID] {
+		if existing["name"] == command["name"] && (command["type"] == nil || existing["type"] == command["type"]) {
+			existing.merge(command)
+			s.state.commands[guildID][i] = existing
+			WriteJSON(w, http.StatusOK, existing)
+			return
+		}
+	}
+	command = s.newCommand(r, command)
+	s.state.commands[guildID] = append(s.state.commands[guildID], command)
+	WriteJSON(w, http.StatusCreated, command)
+}
+
+func (s *serverImpl) setCommands(w http.ResponseWriter, r Request) {
+	var commands []object
+	if err := r.Unmarshal(&commands); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range commands {
+		commands[i] = s.newCommand(r, commands[i])
+	}
+	s.state.commands[r.ID("guild.id")] = commands
+	if commands == nil {
+		commands = []object{}
+	}
+	WriteJSON(w, http.StatusOK, commands)
+}
+
+func (s *serverImpl) updateCommand(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	i, ok := s.findCommand(guildID, r.ID("command.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownApplicationCommand)
+		return
+	}
+	command := s.state.commands[guildID][i]
+	command.merge(update)
+	WriteJSON(w, http.StatusOK, command)
+}
+
+func (s *serverImpl) deleteCommand(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := r.ID("guild.id")
+	i, ok := s.findCommand(guildID, r.ID("command.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownApplicationCommand)
+		return
+	}
+	commands := s.state.commands[guildID]
+	s.state.commands[guildID] = append(commands[:i:i], commands[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *serverImpl) createInteractionResponse(w http.ResponseWriter, r Request) {
+	var response struct {
+		Type discord.InteractionResponseType `json:"type"`
+		Data json.RawMessage                 `json:"data"`
+	}
+	if err := r.Unmarshal(&response); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := r.Params["interaction.token"]
+	if _, ok := s.state.interactionResponses[token]; ok {
+		WriteError(w, http.StatusBadRequest, rest.ErrInteractionAlreadyAcknowledged)
+		return
+	}
+	ir := &interactionResponse{}
+	switch response.Type {
+	case discord.InteractionResponseTypeCreateMessage, discord.InteractionResponseTypeDeferredCreateMessage:
+		message, err := s.newMessage(0, response.Data)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, 0)
+			return
+		}
+		ir.original = message
+	}
+	s.state.interactionResponses[token] = ir
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// interactionResponse returns the interactionResponse of the interaction token or writes an error. The lock must be held.
+func (s *serverImpl) interactionResponse(w http.ResponseWriter, r Request) (*interactionResponse, bool) {
+	ir, ok := s.state.interactionResponses[r.Params["interaction.token"]]
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownWebhook)
+		return nil, false
+	}
+	return ir, true
+}
+
+func (s *serverImpl) getInteractionResponse(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	if ir.original == nil {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	WriteJSON(w, http.StatusOK, ir.original)
+}
+
+func (s *serverImpl) updateInteractionResponse(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	if ir.original == nil {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	ir.original.merge(update)
+	WriteJSON(w, http.StatusOK, ir.original)
+}
+
+func (s *serverImpl) deleteInteractionResponse(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	if ir.original == nil {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	ir.original = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *serverImpl) createFollowupMessage(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	message, err := s.newMessage(0, r.Body)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	ir.followups = append(ir.followups, message)
+	WriteJSON(w, http.StatusOK, message)
+}
+
+func (s *serverImpl) findFollowup(ir *interactionResponse, messageID snowflake.ID) (int, bool) {
+	for i, message := range ir.followups {
+		if message.id("id") == messageID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (s *serverImpl) updateFollowupMessage(w http.ResponseWriter, r Request) {
+	var update object
+	if err := r.Unmarshal(&update); err != nil {
+		WriteError(w, http.StatusBadRequest, 0)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	i, ok := s.findFollowup(ir, r.ID("message.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	ir.followups[i].merge(update)
+	WriteJSON(w, http.StatusOK, ir.followups[i])
+}
+
+func (s *serverImpl) deleteFollowupMessage(w http.ResponseWriter, r Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ir, ok := s.interactionResponse(w, r)
+	if !ok {
+		return
+	}
+	i, ok := s.findFollowup(ir, r.ID("message.id"))
+	if !ok {
+		WriteError(w, http.StatusNotFound, rest.ErrUnknownMessage)
+		return
+	}
+	ir.followups = append(ir.followups[:i:i], ir.followups[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,216 @@
+package disgotest
+
+import (
+	"sort"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// object is a JSON object of an entity. The Server keeps entities as JSON objects, so it doesn't need to know every field of them.
+type object map[string]any
+
+func toObject(v any) (object, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj object
+	if err = json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func fromObject(obj any, v any) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// merge sets all fields of update in the object.
+func (o object) merge(update object) {
+	for k, v := range update {
+		o[k] = v
+	}
+}
+
+func (o object) id(key string) snowflake.ID {
+	str, _ := o[key].(string)
+	id, _ := snowflake.Parse(str)
+	return id
+}
+
+func (o object) copy() object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
+
+type interactionResponse struct {
+	original  object
+	followups []object
+}
+
+// state holds all entities of the Server.
+type state struct {
+	guilds               map[snowflake.ID]object
+	channels             map[snowflake.ID]object
+	members              map[snowflake.ID]map[snowflake.ID]object
+	messages             map[snowflake.ID][]object
+	commands             map[snowflake.ID][]object
+	interactionResponses map[string]*interactionResponse
+}
+
+func newState() *state {
+	return &state{
+		guilds:               map[snowflake.ID]object{},
+		channels:             map[snowflake.ID]object{},
+		members:              map[snowflake.ID]map[snowflake.ID]object{},
+		messages:             map[snowflake.ID][]object{},
+		commands:             map[snowflake.ID][]object{},
+		interactionResponses: map[string]*interactionResponse{},
+	}
+}
+
+// gatewayGuild returns the guild with its channels & members as sent in the guild create event.
+func (s *state) gatewayGuild(guildID snowflake.ID) object {
+	guild := s.guilds[guildID].copy()
+	channels := s.sortedChannels(guildID)
+	if channels == nil {
+		channels = []object{}
+	}
+	guild["channels"] = channels
+	guild["members"] = s.sortedMembers(guildID)
+	return guild
+}
+
+func (s *state) sortedChannels(guildID snowflake.ID) []object {
+	var channels []object
+	for _, channel := range s.channels {
+		if channel.id("guild_id") == guildID {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].id("id") < channels[j].id("id")
+	})
+	return channels
+}
+
+func (s *state) sortedMembers(guildID snowflake.ID) []object {
+	members := make([]object, 0, len(s.members[guildID]))
+	for _, member := range s.members[guildID] {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return memberUserID(members[i]) < memberUserID(members[j])
+	})
+	return members
+}
+
+func memberUserID(member object) snowflake.ID {
+	user, _ := member["user"].(map[string]any)
+	return object(user).id("id")
+}
+
+func (s *serverImpl) AddGuild(guild discord.GatewayGuild) error {
+	obj, err := toObject(guild)
+	if err != nil {
+		return err
+	}
+	channels, _ := obj["channels"].([]any)
+	members, _ := obj["members"].([]any)
+	delete(obj, "channels")
+	delete(obj, "members")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.guilds[guild.ID] = obj
+	for _, c := range channels {
+		channel := object(c.(map[string]any))
+		channel["guild_id"] = guild.ID.String()
+		s.state.channels[channel.id("id")] = channel
+	}
+	if s.state.members[guild.ID] == nil {
+		s.state.members[guild.ID] = map[snowflake.ID]object{}
+	}
+	for _, m := range members {
+		member := object(m.(map[string]any))
+		member["guild_id"] = guild.ID.String()
+		s.state.members[guild.ID][memberUserID(member)] = member
+	}
+
+	if ss, ok := s.sessions[s.shardForGuild(guild.ID)]; ok && ss.ready {
+		s.dispatch(ss, gateway.EventTypeGuildCreate, s.state.gatewayGuild(guild.ID))
+	}
+	return nil
+}
+
+func (s *serverImpl) Guild(guildID snowflake.ID) (discord.Guild, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.state.guilds[guildID]
+	if !ok {
+		return discord.Guild{}, false
+	}
+	var guild discord.Guild
+	if err := fromObject(obj, &guild); err != nil {
+		return discord.Guild{}, false
+	}
+	return guild, true
+}
+
+func (s *serverImpl) Channel(channelID snowflake.ID) (discord.Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.state.channels[channelID]
+	if !ok {
+		return nil, false
+	}
+	var channel discord.UnmarshalChannel
+	if err := fromObject(obj, &channel); err != nil {
+		return nil, false
+	}
+	return channel.Channel, true
+}
+
+func (s *serverImpl) Member(guildID snowflake.ID, userID snowflake.ID) (discord.Member, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.state.members[guildID][userID]
+	if !ok {
+		return discord.Member{}, false
+	}
+	var member discord.Member
+	if err := fromObject(obj, &member); err != nil {
+		return discord.Member{}, false
+	}
+	return member, true
+}
+
+func (s *serverImpl) Messages(channelID snowflake.ID) []discord.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var messages []discord.Message
+	_ = fromObject(s.state.messages[channelID], &messages)
+	return messages
+}
+
+func (s *serverImpl) Commands(guildID snowflake.ID) []discord.ApplicationCommand {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var unmarshalCommands []discord.UnmarshalApplicationCommand
+	_ = fromObject(s.state.commands[guildID], &unmarshalCommands)
+	commands := make([]discord.ApplicationCommand, len(unmarshalCommands))
+	for i := range unmarshalCommands {
+		commands[i] = unmarshalCommands[i].ApplicationCommand
+	}
+	return commands
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	_ bot.AuditLogCorrelatable = (*GuildMemberLeave)(nil)
+	_ bot.AuditLogCorrelatable = (*GuildBan)(nil)
+	_ bot.AuditLogCorrelatable = (*RoleDelete)(nil)
+
+	_ bot.AuditLogCorrelated = (*GuildMemberKick)(nil)
+	_ bot.AuditLogCorrelated = (*GuildBanAuditLog)(nil)
+	_ bot.AuditLogCorrelated = (*RoleDeleteAuditLog)(nil)
+)
+
+// AuditLogTarget returns the target to look for the discord.AuditLogEventMemberKick of the discord.Member.
+func (e *GuildMemberLeave) AuditLogTarget() (snowflake.ID, snowflake.ID, discord.AuditLogEvent) {
+	return e.GuildID, e.User.ID, discord.AuditLogEventMemberKick
+}
+
+// CorrelatedEvent returns the GuildMemberKick for the given discord.AuditLogEntry.
+func (e *GuildMemberLeave) CorrelatedEvent(entry discord.AuditLogEntry) bot.Event {
+	return &GuildMemberKick{GuildMemberLeave: e, AuditLogEntry: entry}
+}
+
+// AuditLogTarget returns the target to look for the discord.AuditLogEventMemberBanAdd of the discord.User.
+func (e *GuildBan) AuditLogTarget() (snowflake.ID, snowflake.ID, discord.AuditLogEvent) {
+	return e.GuildID, e.User.ID, discord.AuditLogEventMemberBanAdd
+}
+
+// CorrelatedEvent returns the GuildBanAuditLog for the given discord.AuditLogEntry.
+func (e *GuildBan) CorrelatedEvent(entry discord.AuditLogEntry) bot.Event {
+	return &GuildBanAuditLog{GuildBan: e, AuditLogEntry: entry}
+}
+
+// AuditLogTarget returns the target to look for the discord.AuditLogEventRoleDelete of the discord.Role.
+func (e *RoleDelete) AuditLogTarget() (snowflake.ID, snowflake.ID, discord.AuditLogEvent) {
+	return e.GuildID, e.RoleID, discord.AuditLogEventRoleDelete
+}
+
+// CorrelatedEvent returns the RoleDeleteAuditLog for the given discord.AuditLogEntry.
+func (e *RoleDelete) CorrelatedEvent(entry discord.AuditLogEntry) bot.Event {
+	return &RoleDeleteAuditLog{RoleDelete: e, AuditLogEntry: entry}
+}
+
+// GuildMemberKick indicates that a discord.Member was kicked from the discord.Guild.
+// It is dispatched by the bot.AuditLogCorrelator after the GuildMemberLeave once the discord.AuditLogEntry of the kick was found.
+// AuditLogEntry.UserID is the moderator who kicked the discord.Member & AuditLogEntry.Reason the given reason.
+type GuildMemberKick struct {
+	*GuildMemberLeave
+	AuditLogEntry discord.AuditLogEntry
+}
+
+// CorrelatedAuditLogEntry returns the AuditLogEntry.
+func (e *GuildMemberKick) CorrelatedAuditLogEntry() discord.AuditLogEntry {
+	return e.AuditLogEntry
+}
+
+// GuildBanAuditLog indicates who banned a discord.User from the discord.Guild.
+// It is dispatched by the bot.AuditLogCorrelator after the GuildBan once the discord.AuditLogEntry of the ban was found.
+// AuditLogEntry.UserID is the moderator who banned the discord.User & AuditLogEntry.Reason the given reason.
+type GuildBanAuditLog struct {
+	*GuildBan
+	AuditLogEntry discord.AuditLogEntry
+}
+
+// CorrelatedAuditLogEntry returns the AuditLogEntry.
+func (e *GuildBanAuditLog) CorrelatedAuditLogEntry() discord.AuditLogEntry {
+	return e.AuditLogEntry
+}
+
+// RoleDeleteAuditLog indicates who deleted a discord.Role.
+// It is dispatched by the bot.AuditLogCorrelator after the RoleDelete once the discord.AuditLogEntry of the deletion was found.
+// AuditLogEntry.UserID is the member who deleted the discord.Role & AuditLogEntry.Reason the given reason.
+type RoleDeleteAuditLog struct {
+	*RoleDelete
+	AuditLogEntry discord.AuditLogEntry
+}
+
+// CorrelatedAuditLogEntry returns the AuditLogEntry.
+func (e *RoleDeleteAuditLog) CorrelatedAuditLogEntry() discord.AuditLogEntry {
+	return e.AuditLogEntry
+}
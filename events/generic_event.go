@@ -1,26 +1,46 @@
 package events
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/rest"
 )
 
-// NewGenericEvent constructs a new GenericEvent with the provided Client instance
-func NewGenericEvent(client bot.Client, sequenceNumber int, shardID int) *GenericEvent {
-	return &GenericEvent{client: client, sequenceNumber: sequenceNumber, shardID: shardID}
+// NewGenericEvent constructs a new GenericEvent with the provided context.Context & Client instance.
+// The context.Context is the one passed to the bot.GatewayEventHandler or bot.HTTPServerEventHandler, use context.Background() for events created outside of them.
+func NewGenericEvent(ctx context.Context, client bot.Client, sequenceNumber int, shardID int) *GenericEvent {
+	return &GenericEvent{ctx: ctx, client: client, sequenceNumber: sequenceNumber, shardID: shardID}
 }
 
 // GenericEvent the base event structure
 type GenericEvent struct {
+	ctx            context.Context
 	client         bot.Client
 	sequenceNumber int
 	shardID        int
 }
 
+// Ctx returns the context.Context of the event which carries the span of the event handling.
+// REST requests made with Rest or the interaction responders use it by default, so their spans are linked to the event.
+func (e *GenericEvent) Ctx() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
+}
+
 // Client returns the bot.Client instance that dispatched the event
 func (e *GenericEvent) Client() bot.Client {
 	return e.client
 }
 
+// Rest returns the rest.Rest of the Client which does all requests with the Ctx of the event unless they set their own with rest.WithCtx.
+// Use it instead of Client().Rest() to link the spans of the requests to the event.
+func (e *GenericEvent) Rest() rest.Rest {
+	return rest.New(rest.NewContextClient(e.client.Rest(), e.Ctx()))
+}
+
 // SequenceNumber returns the sequence number of the gateway event
 func (e *GenericEvent) SequenceNumber() int {
 	return e.sequenceNumber
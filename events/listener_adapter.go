@@ -80,6 +80,7 @@ type ListenerAdapter struct {
 	OnGuildReady       func(event *GuildReady)
 	OnGuildsReady      func(event *GuildsReady)
 	OnGuildBan         func(event *GuildBan)
+	OnGuildBanAuditLog func(event *GuildBanAuditLog)
 	OnGuildUnban       func(event *GuildUnban)
 
 	// Guild Invite Events
@@ -90,6 +91,7 @@ type ListenerAdapter struct {
 	OnGuildMemberJoin   func(event *GuildMemberJoin)
 	OnGuildMemberUpdate func(event *GuildMemberUpdate)
 	OnGuildMemberLeave  func(event *GuildMemberLeave)
+	OnGuildMemberKick   func(event *GuildMemberKick)
 
 	// Guild Message Events
 	OnGuildMessageCreate func(event *GuildMessageCreate)
@@ -115,9 +117,10 @@ type ListenerAdapter struct {
 	OnStageInstanceDelete func(event *StageInstanceDelete)
 
 	// Guild Role Events
-	OnRoleCreate func(event *RoleCreate)
-	OnRoleUpdate func(event *RoleUpdate)
-	OnRoleDelete func(event *RoleDelete)
+	OnRoleCreate         func(event *RoleCreate)
+	OnRoleUpdate         func(event *RoleUpdate)
+	OnRoleDelete         func(event *RoleDelete)
+	OnRoleDeleteAuditLog func(event *RoleDeleteAuditLog)
 
 	// Guild Scheduled Events
 	OnGuildScheduledEventCreate     func(event *GuildScheduledEventCreate)
@@ -383,6 +386,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnGuildBan; listener != nil {
 			listener(e)
 		}
+	case *GuildBanAuditLog:
+		if listener := l.OnGuildBanAuditLog; listener != nil {
+			listener(e)
+		}
 	case *GuildUnban:
 		if listener := l.OnGuildUnban; listener != nil {
 			listener(e)
@@ -411,6 +418,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnGuildMemberLeave; listener != nil {
 			listener(e)
 		}
+	case *GuildMemberKick:
+		if listener := l.OnGuildMemberKick; listener != nil {
+			listener(e)
+		}
 
 	// Guild Message Events
 	case *GuildMessageCreate:
@@ -493,6 +504,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnRoleDelete; listener != nil {
 			listener(e)
 		}
+	case *RoleDeleteAuditLog:
+		if listener := l.OnRoleDeleteAuditLog; listener != nil {
+			listener(e)
+		}
 
 	// Guild ScheduledEvents
 	case *GuildScheduledEventCreate:
@@ -76,13 +76,18 @@ type Gateway interface {
 	// This may be nil if the Gateway was never connected to Discord, was gracefully closed with websocket.CloseNormalClosure or websocket.CloseGoingAway.
 	LastSequenceReceived() *int
 
+	// ResumeGatewayURL returns the URL which is used to resume the session of this Gateway.
+	// This may be nil if the Gateway was never connected to Discord, was gracefully closed with websocket.CloseNormalClosure or websocket.CloseGoingAway.
+	ResumeGatewayURL() *string
+
 	// Intents returns the Intents that are used by this Gateway.
 	Intents() Intents
 
 	// Open connects this Gateway to the Discord API.
 	Open(ctx context.Context) error
 
-	// Close gracefully closes the Gateway with the websocket.CloseNormalClosure code.
+	// Close gracefully closes the Gateway with the websocket.CloseNormalClosure code, which ends the session so it can't be resumed anymore.
+	// If a SessionStore is set, websocket.CloseServiceRestart is used instead to keep the session resumable.
 	// If the context is done, the Gateway connection will be killed.
 	Close(ctx context.Context)
 
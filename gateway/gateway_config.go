@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"github.com/disgoorg/disgo/metrics"
 	"github.com/disgoorg/log"
 	"github.com/gorilla/websocket"
 )
@@ -13,12 +14,15 @@ func DefaultConfig() *Config {
 		LargeThreshold:    50,
 		Intents:           IntentsDefault,
 		Compress:          true,
+		Encoding:          EncodingJSON,
 		URL:               "wss://gateway.discord.gg",
 		ShardID:           0,
 		ShardCount:        1,
 		AutoReconnect:     true,
 		MaxReconnectTries: 10,
 		EnableResumeURL:   true,
+		SessionStoreEvery: 100,
+		Metrics:           metrics.Noop,
 	}
 }
 
@@ -29,6 +33,8 @@ type Config struct {
 	LargeThreshold            int
 	Intents                   Intents
 	Compress                  bool
+	TransportCompression      bool
+	Encoding                  Encoding
 	URL                       string
 	ShardID                   int
 	ShardCount                int
@@ -41,10 +47,14 @@ type Config struct {
 	EnableResumeURL           bool
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
+	IdentifyRateLimiter       IdentifyRateLimiter
 	Presence                  *MessageDataPresenceUpdate
 	OS                        string
 	Browser                   string
 	Device                    string
+	SessionStore              SessionStore
+	SessionStoreEvery         int
+	Metrics                   metrics.Metrics
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
@@ -98,6 +108,23 @@ func WithCompress(compress bool) ConfigOpt {
 	}
 }
 
+// WithTransportCompression sets whether this Gateway uses zlib-stream transport compression.
+// This compresses the whole connection with a single shared inflate context and takes precedence over WithCompress.
+// See here for more information: https://discord.com/developers/docs/topics/gateway#transport-compression
+func WithTransportCompression(transportCompression bool) ConfigOpt {
+	return func(config *Config) {
+		config.TransportCompression = transportCompression
+	}
+}
+
+// WithEncoding sets the Encoding the Gateway uses to send & receive payloads.
+// See here for more information: https://discord.com/developers/docs/topics/gateway#encoding-and-compression
+func WithEncoding(encoding Encoding) ConfigOpt {
+	return func(config *Config) {
+		config.Encoding = encoding
+	}
+}
+
 // WithURL sets the Gateway URL for the Gateway.
 func WithURL(url string) ConfigOpt {
 	return func(config *Config) {
@@ -137,6 +164,14 @@ func WithSequence(sequence int) ConfigOpt {
 	}
 }
 
+// WithResumeGatewayURL sets the URL used to resume the session of the Gateway.
+// This is only used if WithEnableResumeURL is enabled and sessionID and lastSequence are present while connecting.
+func WithResumeGatewayURL(resumeGatewayURL string) ConfigOpt {
+	return func(config *Config) {
+		config.ResumeGatewayURL = &resumeGatewayURL
+	}
+}
+
 // WithAutoReconnect sets whether the Gateway should automatically reconnect to Discord.
 func WithAutoReconnect(autoReconnect bool) ConfigOpt {
 	return func(config *Config) {
@@ -165,6 +200,30 @@ func WithEnableResumeURL(enableResumeURL bool) ConfigOpt {
 	}
 }
 
+// WithSessionStore sets the SessionStore of the Gateway.
+// The Gateway loads its Session from the SessionStore when opened without a session ID & sequence and resumes it.
+// The Session is written after the ready event, every SessionStoreEvery dispatches and when the Gateway is closed.
+// As Discord invalidates sessions closed with websocket.CloseNormalClosure, Close uses websocket.CloseServiceRestart instead if a SessionStore is set.
+func WithSessionStore(sessionStore SessionStore) ConfigOpt {
+	return func(config *Config) {
+		config.SessionStore = sessionStore
+	}
+}
+
+// WithSessionStoreEvery sets after how many dispatches the Session is written to the SessionStore. 0 disables periodic writes.
+func WithSessionStoreEvery(dispatches int) ConfigOpt {
+	return func(config *Config) {
+		config.SessionStoreEvery = dispatches
+	}
+}
+
+// WithMetrics sets the metrics.Metrics which records the heartbeat latency, reconnects & received events of the Gateway.
+func WithMetrics(m metrics.Metrics) ConfigOpt {
+	return func(config *Config) {
+		config.Metrics = m
+	}
+}
+
 // WithRateLimiter sets the grate.RateLimiter for the Gateway.
 func WithRateLimiter(rateLimiter RateLimiter) ConfigOpt {
 	return func(config *Config) {
@@ -179,6 +238,14 @@ func WithRateRateLimiterConfigOpts(opts ...RateLimiterConfigOpt) ConfigOpt {
 	}
 }
 
+// WithIdentifyRateLimiter lets you inject your own IdentifyRateLimiter which is waited on before every identify.
+// The sharding.ShardManager sets its sharding.RateLimiter here.
+func WithIdentifyRateLimiter(identifyRateLimiter IdentifyRateLimiter) ConfigOpt {
+	return func(config *Config) {
+		config.IdentifyRateLimiter = identifyRateLimiter
+	}
+}
+
 // WithPresenceOpts allows to pass initial presence data the bot should display.
 func WithPresenceOpts(opts ...PresenceOpt) ConfigOpt {
 	return func(config *Config) {
@@ -0,0 +1,14 @@
+package gateway
+
+// Encoding is the payload encoding used to communicate with the Discord gateway.
+// See here for more information: https://discord.com/developers/docs/topics/gateway#encoding-and-compression
+type Encoding string
+
+const (
+	// EncodingJSON encodes payloads as JSON text messages.
+	EncodingJSON Encoding = "json"
+
+	// EncodingETF encodes payloads as Erlang External Term Format binary messages.
+	// Snowflakes are transmitted as integers and transcoded to the JSON representation disgo uses internally.
+	EncodingETF Encoding = "etf"
+)
@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/internal/etf"
+)
+
+func TestMessage_ETFRoundTrip(t *testing.T) {
+	channelID := snowflake.ID(1012345678901234567)
+	messages := []Message{
+		{Op: OpcodeHello, D: MessageDataHello{HeartbeatInterval: 41250}},
+		{Op: OpcodeHeartbeat, D: MessageDataHeartbeat(42)},
+		{Op: OpcodeInvalidSession, D: MessageDataInvalidSession(true)},
+		{Op: OpcodeIdentify, D: MessageDataIdentify{
+			Token: "token",
+			Properties: IdentifyCommandDataProperties{
+				OS:      "linux",
+				Browser: "disgo",
+				Device:  "disgo",
+			},
+			LargeThreshold: 50,
+			Shard:          &[2]int{1, 2},
+			Intents:        IntentsDefault,
+		}},
+		{Op: OpcodeVoiceStateUpdate, D: MessageDataVoiceStateUpdate{
+			GuildID:   snowflake.ID(1012345678901234568),
+			ChannelID: &channelID,
+			SelfDeaf:  true,
+		}},
+		{Op: OpcodeDispatch, S: 1, T: EventTypeReady, D: EventReady{
+			Version: 10,
+			User: discord.OAuth2User{User: discord.User{
+				ID:       snowflake.ID(1012345678901234569),
+				Username: "disgo",
+				Bot:      true,
+			}},
+			Guilds:           []discord.UnavailableGuild{{ID: snowflake.ID(1012345678901234570), Unavailable: true}},
+			SessionID:        "session",
+			ResumeGatewayURL: "wss://gateway.discord.gg",
+			Shard:            []int{0, 1},
+			Application: discord.PartialApplication{
+				ID:    snowflake.ID(1012345678901234569),
+				Flags: discord.ApplicationFlagGatewayPresence,
+			},
+		}},
+		{Op: OpcodeDispatch, S: 2, T: EventTypeGuildRoleDelete, D: EventGuildRoleDelete{
+			GuildID: snowflake.ID(1012345678901234570),
+			RoleID:  snowflake.ID(1012345678901234571),
+		}},
+	}
+
+	for _, message := range messages {
+		data, err := json.Marshal(message)
+		require.NoError(t, err)
+
+		etfData, err := etf.FromJSON(data)
+		require.NoError(t, err)
+		assert.Equal(t, byte(etf.Version), etfData[0])
+
+		jsonData, err := etf.ToJSON(etfData)
+		require.NoError(t, err)
+
+		var decoded Message
+		require.NoError(t, json.Unmarshal(jsonData, &decoded))
+		assert.Equal(t, message.Op, decoded.Op)
+		assert.Equal(t, message.S, decoded.S)
+		assert.Equal(t, message.T, decoded.T)
+		assert.Equal(t, message.D, decoded.D)
+	}
+}
+
+func TestMessage_ETFIntegerSnowflakes(t *testing.T) {
+	// {"op" => 0, "s" => 3, "t" => :GUILD_ROLE_DELETE, "d" => {"guild_id" => 1012345678901234570, "role_id" => 1012345678901234571}}
+	// discord sends snowflakes as small big integers and keys as binaries
+	data := []byte{etf.Version, 116, 0, 0, 0, 4,
+		109, 0, 0, 0, 2, 'o', 'p', 97, 0,
+		109, 0, 0, 0, 1, 's', 97, 3,
+		109, 0, 0, 0, 1, 't', 119, 17, 'G', 'U', 'I', 'L', 'D', '_', 'R', 'O', 'L', 'E', '_', 'D', 'E', 'L', 'E', 'T', 'E',
+		109, 0, 0, 0, 1, 'd', 116, 0, 0, 0, 2,
+		109, 0, 0, 0, 8, 'g', 'u', 'i', 'l', 'd', '_', 'i', 'd', 110, 8, 0, 0x8a, 0x4b, 0xcf, 0x04, 0x08, 0x93, 0x0c, 0x0e,
+		109, 0, 0, 0, 7, 'r', 'o', 'l', 'e', '_', 'i', 'd', 110, 8, 0, 0x8b, 0x4b, 0xcf, 0x04, 0x08, 0x93, 0x0c, 0x0e,
+	}
+
+	jsonData, err := etf.ToJSON(data)
+	require.NoError(t, err)
+
+	var message Message
+	require.NoError(t, json.Unmarshal(jsonData, &message))
+	assert.Equal(t, EventTypeGuildRoleDelete, message.T)
+	assert.Equal(t, EventGuildRoleDelete{
+		GuildID: snowflake.ID(1012345678901234570),
+		RoleID:  snowflake.ID(1012345678901234571),
+	}, message.D)
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/internal/etf"
 	"github.com/disgoorg/disgo/internal/tokenhelper"
 	"github.com/disgoorg/json"
 	"github.com/gorilla/websocket"
@@ -42,12 +43,16 @@ type gatewayImpl struct {
 
 	conn            *websocket.Conn
 	connMu          sync.Mutex
+	zlibStream      *zlibStream
 	heartbeatTicker *time.Ticker
 	status          Status
 
 	heartbeatInterval     time.Duration
 	lastHeartbeatSent     time.Time
 	lastHeartbeatReceived time.Time
+
+	// dispatches counts the received dispatches to periodically write the Session to the SessionStore
+	dispatches int
 }
 
 func (g *gatewayImpl) ShardID() int {
@@ -59,13 +64,23 @@ func (g *gatewayImpl) ShardCount() int {
 }
 
 func (g *gatewayImpl) SessionID() *string {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
 	return g.config.SessionID
 }
 
 func (g *gatewayImpl) LastSequenceReceived() *int {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
 	return g.config.LastSequenceReceived
 }
 
+func (g *gatewayImpl) ResumeGatewayURL() *string {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	return g.config.ResumeGatewayURL
+}
+
 func (g *gatewayImpl) Intents() Intents {
 	return g.config.Intents
 }
@@ -94,11 +109,18 @@ func (g *gatewayImpl) Open(ctx context.Context) error {
 	}
 	g.status = StatusConnecting
 
+	if g.config.SessionStore != nil && (g.config.SessionID == nil || g.config.LastSequenceReceived == nil) {
+		g.loadSession()
+	}
+
 	wsURL := g.config.URL
 	if g.config.ResumeGatewayURL != nil && g.config.EnableResumeURL {
 		wsURL = *g.config.ResumeGatewayURL
 	}
-	gatewayURL := fmt.Sprintf("%s?v=%d&encoding=json", wsURL, Version)
+	gatewayURL := fmt.Sprintf("%s?v=%d&encoding=%s", wsURL, Version, g.config.Encoding)
+	if g.config.TransportCompression {
+		gatewayURL += "&compress=zlib-stream"
+	}
 	g.lastHeartbeatSent = time.Now().UTC()
 	conn, rs, err := g.config.Dialer.DialContext(ctx, gatewayURL, nil)
 	if err != nil {
@@ -125,29 +147,41 @@ func (g *gatewayImpl) Open(ctx context.Context) error {
 
 	g.conn = conn
 
+	// every connection needs a fresh inflate context
+	var stream *zlibStream
+	if g.config.TransportCompression {
+		stream = newZlibStream(g.config.Encoding)
+		g.zlibStream = stream
+	}
+
 	// reset rate limiter when connecting
 	g.config.RateLimiter.Reset()
 
 	g.status = StatusWaitingForHello
 
-	go g.listen(conn)
+	go g.listen(conn, stream)
 
 	return nil
 }
 
 func (g *gatewayImpl) Close(ctx context.Context) {
+	if g.config.SessionStore != nil {
+		// keep the session resumable so it can be picked up again from the SessionStore
+		g.CloseWithCode(ctx, websocket.CloseServiceRestart, "Restarting")
+		return
+	}
 	g.CloseWithCode(ctx, websocket.CloseNormalClosure, "Shutting down")
 }
 
 func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message string) {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
 	if g.heartbeatTicker != nil {
 		g.config.Logger.Debug(g.formatLogs("closing heartbeat goroutines..."))
 		g.heartbeatTicker.Stop()
 		g.heartbeatTicker = nil
 	}
 
-	g.connMu.Lock()
-	defer g.connMu.Unlock()
 	if g.conn != nil {
 		g.config.RateLimiter.Close(ctx)
 		g.config.Logger.Debug(g.formatLogsf("closing gateway connection with code: %d, message: %s", code, message))
@@ -157,14 +191,64 @@ func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message strin
 		_ = g.conn.Close()
 		g.conn = nil
 
+		if g.zlibStream != nil {
+			g.zlibStream.Close()
+			g.zlibStream = nil
+		}
+
 		// clear resume data as we closed gracefully
 		if code == websocket.CloseNormalClosure || code == websocket.CloseGoingAway {
 			g.config.SessionID = nil
 			g.config.ResumeGatewayURL = nil
 			g.config.LastSequenceReceived = nil
 		}
+		g.storeSession()
+	}
+}
+
+// loadSession loads the Session of this shard from the SessionStore. The caller must hold the connMu lock.
+func (g *gatewayImpl) loadSession() {
+	session, err := g.config.SessionStore.Session(g.config.ShardID)
+	if err != nil {
+		g.config.Logger.Error(g.formatLogs("error while loading session from session store: ", err))
+		return
+	}
+	if session == nil {
+		return
+	}
+	if session.ShardCount != g.config.ShardCount {
+		g.config.Logger.Debug(g.formatLogsf("ignoring stored session for shard count %d", session.ShardCount))
+		return
+	}
+	g.config.Logger.Debug(g.formatLogs("loaded session from session store"))
+	g.config.SessionID = &session.SessionID
+	g.config.LastSequenceReceived = &session.LastSequenceReceived
+	g.config.ResumeGatewayURL = &session.ResumeGatewayURL
+}
+
+// storeSession writes the current Session to the SessionStore or deletes it if the session can't be resumed anymore. The caller must hold the connMu lock.
+func (g *gatewayImpl) storeSession() {
+	if g.config.SessionStore == nil {
+		return
+	}
+	if g.config.SessionID == nil || g.config.LastSequenceReceived == nil {
+		if err := g.config.SessionStore.DeleteSession(g.config.ShardID); err != nil {
+			g.config.Logger.Error(g.formatLogs("error while deleting session from session store: ", err))
+		}
+		return
 	}
 
+	session := Session{
+		SessionID:            *g.config.SessionID,
+		LastSequenceReceived: *g.config.LastSequenceReceived,
+		ShardCount:           g.config.ShardCount,
+	}
+	if g.config.ResumeGatewayURL != nil {
+		session.ResumeGatewayURL = *g.config.ResumeGatewayURL
+	}
+	if err := g.config.SessionStore.PutSession(g.config.ShardID, session); err != nil {
+		g.config.Logger.Error(g.formatLogs("error while writing session to session store: ", err))
+	}
 }
 
 func (g *gatewayImpl) Status() Status {
@@ -173,6 +257,12 @@ func (g *gatewayImpl) Status() Status {
 	return g.status
 }
 
+func (g *gatewayImpl) setStatus(status Status) {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	g.status = status
+}
+
 func (g *gatewayImpl) Send(ctx context.Context, op Opcode, d MessageData) error {
 	data, err := json.Marshal(Message{
 		Op: op,
@@ -181,6 +271,12 @@ func (g *gatewayImpl) Send(ctx context.Context, op Opcode, d MessageData) error
 	if err != nil {
 		return err
 	}
+	if g.config.Encoding == EncodingETF {
+		if data, err = etf.FromJSON(data); err != nil {
+			return err
+		}
+		return g.send(ctx, websocket.BinaryMessage, data)
+	}
 	return g.send(ctx, websocket.TextMessage, data)
 }
 
@@ -227,25 +323,25 @@ func (g *gatewayImpl) reconnectTry(ctx context.Context, try int, delay time.Dura
 			return err
 		}
 		g.config.Logger.Error(g.formatLogs("failed to reconnect gateway. error: ", err))
-		g.status = StatusDisconnected
+		g.setStatus(StatusDisconnected)
 		return g.reconnectTry(ctx, try+1, delay)
 	}
 	return nil
 }
 
 func (g *gatewayImpl) reconnect(ctx context.Context) {
+	g.config.Metrics.GatewayReconnect(g.config.ShardID)
 	err := g.reconnectTry(ctx, 0, time.Second)
 	if err != nil {
 		g.config.Logger.Error(g.formatLogs("failed to reopen gateway. error: ", err))
 	}
 }
 
-func (g *gatewayImpl) heartbeat() {
-	g.heartbeatTicker = time.NewTicker(g.heartbeatInterval)
-	defer g.heartbeatTicker.Stop()
+func (g *gatewayImpl) heartbeat(ticker *time.Ticker) {
+	defer ticker.Stop()
 	defer g.config.Logger.Debug(g.formatLogs("exiting heartbeat goroutine..."))
 
-	for range g.heartbeatTicker.C {
+	for range ticker.C {
 		g.sendHeartbeat()
 	}
 }
@@ -255,7 +351,7 @@ func (g *gatewayImpl) sendHeartbeat() {
 
 	ctx, cancel := context.WithTimeout(context.Background(), g.heartbeatInterval)
 	defer cancel()
-	if err := g.Send(ctx, OpcodeHeartbeat, MessageDataHeartbeat(*g.config.LastSequenceReceived)); err != nil {
+	if err := g.Send(ctx, OpcodeHeartbeat, MessageDataHeartbeat(*g.LastSequenceReceived())); err != nil {
 		if err == discord.ErrShardNotConnected || errors.Is(err, syscall.EPIPE) {
 			return
 		}
@@ -268,7 +364,7 @@ func (g *gatewayImpl) sendHeartbeat() {
 }
 
 func (g *gatewayImpl) identify() {
-	g.status = StatusIdentifying
+	g.setStatus(StatusIdentifying)
 	g.config.Logger.Debug(g.formatLogs("sending Identify command..."))
 
 	identify := MessageDataIdentify{
@@ -278,7 +374,7 @@ func (g *gatewayImpl) identify() {
 			Browser: g.config.Browser,
 			Device:  g.config.Device,
 		},
-		Compress:       g.config.Compress,
+		Compress:       g.config.Compress && !g.config.TransportCompression,
 		LargeThreshold: g.config.LargeThreshold,
 		Intents:        g.config.Intents,
 		Presence:       g.config.Presence,
@@ -287,19 +383,30 @@ func (g *gatewayImpl) identify() {
 		identify.Shard = &[2]int{g.ShardID(), g.ShardCount()}
 	}
 
+	// every identify counts against the max concurrency, also the ones after a failed resume
+	if g.config.IdentifyRateLimiter != nil {
+		if err := g.config.IdentifyRateLimiter.WaitBucket(context.TODO(), g.ShardID()); err != nil {
+			g.config.Logger.Error(g.formatLogs("error waiting for identify bucket err: ", err))
+			return
+		}
+		defer g.config.IdentifyRateLimiter.UnlockBucket(g.ShardID())
+	}
+
 	if err := g.Send(context.TODO(), OpcodeIdentify, identify); err != nil {
 		g.config.Logger.Error(g.formatLogs("error sending Identify command err: ", err))
 	}
-	g.status = StatusWaitingForReady
+	g.setStatus(StatusWaitingForReady)
 }
 
 func (g *gatewayImpl) resume() {
+	g.connMu.Lock()
 	g.status = StatusResuming
 	resume := MessageDataResume{
 		Token:     g.token,
 		SessionID: *g.config.SessionID,
 		Seq:       *g.config.LastSequenceReceived,
 	}
+	g.connMu.Unlock()
 
 	g.config.Logger.Debug(g.formatLogs("sending Resume command..."))
 	if err := g.Send(context.TODO(), OpcodeResume, resume); err != nil {
@@ -307,7 +414,7 @@ func (g *gatewayImpl) resume() {
 	}
 }
 
-func (g *gatewayImpl) listen(conn *websocket.Conn) {
+func (g *gatewayImpl) listen(conn *websocket.Conn, stream *zlibStream) {
 	defer g.config.Logger.Debug(g.formatLogs("exiting listen goroutine..."))
 loop:
 	for {
@@ -337,9 +444,11 @@ loop:
 					g.config.Logger.Error(g.formatLogsf("disallowed gateway intents supplied. go to %s and enable the privileged intent for your application. intents: %d", intentsURL, g.config.Intents))
 				} else if closeCode == CloseEventCodeInvalidSeq {
 					g.config.Logger.Error(g.formatLogs("invalid sequence provided. reconnecting..."))
+					g.connMu.Lock()
 					g.config.LastSequenceReceived = nil
 					g.config.SessionID = nil
 					g.config.ResumeGatewayURL = nil
+					g.connMu.Unlock()
 				} else {
 					message := g.formatLogsf("gateway close received, reconnect: %t, code: %d, error: %s", g.config.AutoReconnect && reconnect, closeError.Code, closeError.Text)
 					if reconnect {
@@ -366,8 +475,33 @@ loop:
 			break loop
 		}
 
-		event, err := g.parseMessage(mt, reader)
+		data, err := g.readMessage(mt, reader, stream)
 		if err != nil {
+			if mt == websocket.BinaryMessage && stream != nil {
+				g.connMu.Lock()
+				sameConnection := g.conn == conn
+				g.connMu.Unlock()
+				if !sameConnection {
+					return
+				}
+
+				// the inflate context can't recover from a broken frame, resume on a new connection with a fresh one
+				g.config.Logger.Error(g.formatLogs("error while inflating zlib stream. reconnecting... error: ", err))
+				g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "zlib stream error")
+				go g.reconnect(context.TODO())
+				break loop
+			}
+			g.config.Logger.Error(g.formatLogs("error while reading gateway message. error: ", err))
+			continue
+		}
+		if data == nil {
+			// the zlib-stream payload is split across multiple messages, wait for the rest
+			continue
+		}
+		g.config.Logger.Trace(g.formatLogs("received gateway message: ", string(data)))
+
+		var event Message
+		if err = json.Unmarshal(data, &event); err != nil {
 			g.config.Logger.Error(g.formatLogs("error while parsing gateway message. error: ", err))
 			continue
 		}
@@ -375,11 +509,15 @@ loop:
 		switch event.Op {
 		case OpcodeHello:
 			g.lastHeartbeatReceived = time.Now().UTC()
-			go g.heartbeat()
-
 			g.heartbeatInterval = time.Duration(event.D.(MessageDataHello).HeartbeatInterval) * time.Millisecond
 
-			if g.config.LastSequenceReceived == nil || g.config.SessionID == nil {
+			g.connMu.Lock()
+			g.heartbeatTicker = time.NewTicker(g.heartbeatInterval)
+			go g.heartbeat(g.heartbeatTicker)
+			canResume := g.config.LastSequenceReceived != nil && g.config.SessionID != nil
+			g.connMu.Unlock()
+
+			if !canResume {
 				g.identify()
 			} else {
 				g.resume()
@@ -387,7 +525,10 @@ loop:
 
 		case OpcodeDispatch:
 			// set last sequence received
+			g.connMu.Lock()
 			g.config.LastSequenceReceived = &event.S
+			g.connMu.Unlock()
+			g.config.Metrics.GatewayEvent(g.config.ShardID, string(event.T))
 
 			data, ok := event.D.(EventData)
 			if !ok && event.D != nil {
@@ -397,9 +538,11 @@ loop:
 
 			// get session id here
 			if readyEvent, ok := data.(EventReady); ok {
+				g.connMu.Lock()
 				g.config.SessionID = &readyEvent.SessionID
 				g.config.ResumeGatewayURL = &readyEvent.ResumeGatewayURL
 				g.status = StatusReady
+				g.connMu.Unlock()
 				g.config.Logger.Debug(g.formatLogs("ready event received"))
 			}
 
@@ -412,6 +555,15 @@ loop:
 			}
 			g.eventHandlerFunc(event.T, event.S, g.config.ShardID, data)
 
+			if g.config.SessionStore != nil {
+				g.dispatches++
+				if event.T == EventTypeReady || (g.config.SessionStoreEvery > 0 && g.dispatches%g.config.SessionStoreEvery == 0) {
+					g.connMu.Lock()
+					g.storeSession()
+					g.connMu.Unlock()
+				}
+			}
+
 		case OpcodeHeartbeat:
 			g.sendHeartbeat()
 
@@ -428,9 +580,11 @@ loop:
 				code = websocket.CloseServiceRestart
 			} else {
 				// clear resume info
+				g.connMu.Lock()
 				g.config.SessionID = nil
 				g.config.LastSequenceReceived = nil
 				g.config.ResumeGatewayURL = nil
+				g.connMu.Unlock()
 			}
 
 			g.CloseWithCode(context.TODO(), code, "invalid session")
@@ -439,33 +593,41 @@ loop:
 
 		case OpcodeHeartbeatACK:
 			g.lastHeartbeatReceived = time.Now().UTC()
+			g.config.Metrics.GatewayHeartbeatLatency(g.config.ShardID, g.Latency())
 		}
 	}
 }
 
-func (g *gatewayImpl) parseMessage(mt int, reader io.Reader) (Message, error) {
-	var readCloser io.ReadCloser
-	if mt == websocket.BinaryMessage {
-		g.config.Logger.Trace(g.formatLogs("binary message received. decompressing..."))
-		var err error
-		readCloser, err = zlib.NewReader(reader)
-		if err != nil {
-			return Message{}, fmt.Errorf("failed to decompress zlib: %w", err)
-		}
-	} else {
-		readCloser = io.NopCloser(reader)
+// readMessage reads the raw payload of the given websocket message and returns it as JSON.
+// It returns nil if the message is only a part of a zlib-stream payload.
+func (g *gatewayImpl) readMessage(mt int, reader io.Reader, stream *zlibStream) ([]byte, error) {
+	if mt == websocket.BinaryMessage && stream != nil {
+		g.config.Logger.Trace(g.formatLogs("binary message received. inflating zlib stream..."))
+		return stream.Read(reader)
 	}
-	defer func() {
-		_ = readCloser.Close()
-	}()
 
-	buff := new(bytes.Buffer)
-	r := io.TeeReader(readCloser, buff)
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
 
-	data, _ := io.ReadAll(r)
-	g.config.Logger.Trace(g.formatLogs("received gateway message: ", string(data)))
+	// etf payloads are always binary, so only zlib compressed payloads start with something else than the etf version
+	if mt == websocket.BinaryMessage && (g.config.Encoding != EncodingETF || len(data) == 0 || data[0] != etf.Version) {
+		g.config.Logger.Trace(g.formatLogs("binary message received. decompressing..."))
+		var readCloser io.ReadCloser
+		if readCloser, err = zlib.NewReader(bytes.NewReader(data)); err != nil {
+			return nil, fmt.Errorf("failed to decompress zlib: %w", err)
+		}
+		defer func() {
+			_ = readCloser.Close()
+		}()
+		if data, err = io.ReadAll(readCloser); err != nil {
+			return nil, fmt.Errorf("failed to decompress zlib: %w", err)
+		}
+	}
 
-	var message Message
-	err := json.NewDecoder(buff).Decode(&message)
-	return message, err
+	if g.config.Encoding == EncodingETF {
+		return etf.ToJSON(data)
+	}
+	return data, nil
 }
@@ -20,3 +20,14 @@ type RateLimiter interface {
 	// Unlock unlocks the RateLimiter and allows the next message to be sent.
 	Unlock()
 }
+
+// IdentifyRateLimiter limits how many shards can identify at the same time. It is implemented by sharding.RateLimiter.
+// See here for more information: https://discord.com/developers/docs/topics/gateway#sharding-max-concurrency
+type IdentifyRateLimiter interface {
+	// WaitBucket waits for the bucket of the given shardID to be available for a new identify.
+	// If the context deadline is exceeded, WaitBucket will return immediately and no identify will be sent.
+	WaitBucket(ctx context.Context, shardID int) error
+
+	// UnlockBucket unlocks the bucket of the given shardID.
+	UnlockBucket(shardID int)
+}
@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/disgoorg/json"
+)
+
+// Session holds the information required to resume the session of a Gateway.
+type Session struct {
+	SessionID            string `json:"session_id"`
+	LastSequenceReceived int    `json:"last_sequence_received"`
+	ResumeGatewayURL     string `json:"resume_gateway_url"`
+	ShardCount           int    `json:"shard_count"`
+}
+
+// SessionStore is used to persist the Session of a Gateway, so it can be resumed after a restart instead of identifying again.
+// Implementations must be thread safe as all shards of a sharding.ShardManager share the same SessionStore.
+type SessionStore interface {
+	// Session returns the stored Session of the given shard or nil if none is stored.
+	Session(shardID int) (*Session, error)
+
+	// PutSession stores the Session of the given shard.
+	PutSession(shardID int, session Session) error
+
+	// DeleteSession removes the stored Session of the given shard.
+	DeleteSession(shardID int) error
+}
+
+var _ SessionStore = (*fileSessionStore)(nil)
+
+// NewFileSessionStore returns a SessionStore which stores the Session(s) of all shards as JSON in the file at the given path.
+// The file is created if it does not exist and is replaced atomically on every write.
+func NewFileSessionStore(path string) SessionStore {
+	return &fileSessionStore{
+		path: path,
+	}
+}
+
+type fileSessionStore struct {
+	mu       sync.Mutex
+	path     string
+	sessions map[int]Session
+}
+
+// load reads the file once. The caller must hold the lock.
+func (s *fileSessionStore) load() error {
+	if s.sessions != nil {
+		return nil
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.sessions = map[int]Session{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	sessions := map[int]Session{}
+	if err = json.Unmarshal(data, &sessions); err != nil {
+		return err
+	}
+	s.sessions = sessions
+	return nil
+}
+
+// save writes all sessions to a temporary file and renames it to the path afterwards. The caller must hold the lock.
+func (s *fileSessionStore) save() error {
+	data, err := json.Marshal(s.sessions)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+	if _, err = file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.path)
+}
+
+func (s *fileSessionStore) Session(shardID int) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	session, ok := s.sessions[shardID]
+	if !ok {
+		return nil, nil
+	}
+	return &session, nil
+}
+
+func (s *fileSessionStore) PutSession(shardID int, session Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	s.sessions[shardID] = session
+	return s.save()
+}
+
+func (s *fileSessionStore) DeleteSession(shardID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	if _, ok := s.sessions[shardID]; !ok {
+		return nil
+	}
+	delete(s.sessions, shardID)
+	return s.save()
+}
@@ -0,0 +1,31 @@
+package gateway
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSessionStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.json")
+
+	store := NewFileSessionStore(path)
+	session, err := store.Session(0)
+	assert.NoError(t, err)
+	assert.Nil(t, session)
+
+	assert.NoError(t, store.PutSession(0, Session{SessionID: "abc", LastSequenceReceived: 1, ShardCount: 2}))
+	assert.NoError(t, store.PutSession(1, Session{SessionID: "def", LastSequenceReceived: 2, ShardCount: 2}))
+	assert.NoError(t, store.DeleteSession(1))
+
+	// a new store reads the sessions written by the previous process
+	store = NewFileSessionStore(path)
+	session, err = store.Session(0)
+	assert.NoError(t, err)
+	assert.Equal(t, &Session{SessionID: "abc", LastSequenceReceived: 1, ShardCount: 2}, session)
+
+	session, err = store.Session(1)
+	assert.NoError(t, err)
+	assert.Nil(t, session)
+}
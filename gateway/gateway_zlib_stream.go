@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/disgoorg/disgo/internal/etf"
+	"github.com/disgoorg/json"
+)
+
+// zlibSuffix is the Z_SYNC_FLUSH suffix Discord appends to the last websocket message of each payload when using zlib-stream transport compression.
+var zlibSuffix = []byte{0x00, 0x00, 0xFF, 0xFF}
+
+// errZlibStreamClosed is returned when trying to write to a closed zlibStream.
+var errZlibStreamClosed = errors.New("zlib stream closed")
+
+// zlibStream keeps a single inflate context for the whole lifetime of a gateway connection as required by the zlib-stream transport compression.
+// See here for more information: https://discord.com/developers/docs/topics/gateway#transport-compression
+type zlibStream struct {
+	encoding Encoding
+	writer   *io.PipeWriter
+	payloads chan zlibStreamPayload
+	done     chan struct{}
+}
+
+type zlibStreamPayload struct {
+	data json.RawMessage
+	err  error
+}
+
+// newZlibStream creates a new zlibStream for payloads of the given Encoding and starts the inflate goroutine.
+func newZlibStream(encoding Encoding) *zlibStream {
+	reader, writer := io.Pipe()
+	z := &zlibStream{
+		encoding: encoding,
+		writer:   writer,
+		// buffered so the inflate goroutine can consume the remaining flush bytes while the payload waits to be read
+		payloads: make(chan zlibStreamPayload, 1),
+		done:     make(chan struct{}),
+	}
+	go z.inflate(reader)
+	return z
+}
+
+func (z *zlibStream) inflate(reader *io.PipeReader) {
+	var err error
+	defer func() {
+		_ = reader.CloseWithError(err)
+	}()
+
+	var zlibReader io.ReadCloser
+	if zlibReader, err = zlib.NewReader(reader); err != nil {
+		z.sendPayload(zlibStreamPayload{err: fmt.Errorf("failed to create zlib reader: %w", err)})
+		return
+	}
+	defer zlibReader.Close()
+
+	// every payload is a single json value or etf term, so we can just decode them one after another
+	var decode func() ([]byte, error)
+	if z.encoding == EncodingETF {
+		decode = etf.NewDecoder(zlibReader).Decode
+	} else {
+		decoder := json.NewDecoder(zlibReader)
+		decode = func() ([]byte, error) {
+			var data json.RawMessage
+			err := decoder.Decode(&data)
+			return data, err
+		}
+	}
+
+	for {
+		var data []byte
+		if data, err = decode(); err != nil {
+			z.sendPayload(zlibStreamPayload{err: fmt.Errorf("failed to decompress zlib stream: %w", err)})
+			return
+		}
+		if !z.sendPayload(zlibStreamPayload{data: data}) {
+			return
+		}
+	}
+}
+
+func (z *zlibStream) sendPayload(payload zlibStreamPayload) bool {
+	select {
+	case z.payloads <- payload:
+		return true
+	case <-z.done:
+		return false
+	}
+}
+
+// Read reads the given websocket message into the inflate context.
+// It returns the decompressed payload as JSON once a message ending with the Z_SYNC_FLUSH suffix was read, otherwise nil.
+func (z *zlibStream) Read(reader io.Reader) (json.RawMessage, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = z.writer.Write(data); err != nil {
+		if err == io.ErrClosedPipe {
+			return nil, errZlibStreamClosed
+		}
+		return nil, err
+	}
+
+	if !bytes.HasSuffix(data, zlibSuffix) {
+		return nil, nil
+	}
+
+	select {
+	case payload := <-z.payloads:
+		return payload.data, payload.err
+	case <-z.done:
+		return nil, errZlibStreamClosed
+	}
+}
+
+// Close closes the zlibStream and stops the inflate goroutine.
+func (z *zlibStream) Close() {
+	select {
+	case <-z.done:
+		return
+	default:
+	}
+	close(z.done)
+	_ = z.writer.Close()
+}
@@ -0,0 +1,154 @@
+package gateway
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/internal/etf"
+)
+
+func TestZlibStream_Read(t *testing.T) {
+	payloads := []string{
+		`{"op":10,"d":{"heartbeat_interval":41250}}`,
+		`{"op":11}`,
+		`{"op":0,"s":1,"t":"RESUMED","d":null}`,
+	}
+
+	compressed := new(bytes.Buffer)
+	writer := zlib.NewWriter(compressed)
+
+	stream := newZlibStream(EncodingJSON)
+	defer stream.Close()
+
+	for _, payload := range payloads {
+		compressed.Reset()
+		_, err := writer.Write([]byte(payload))
+		require.NoError(t, err)
+		require.NoError(t, writer.Flush())
+
+		// split each payload into two websocket messages
+		data := compressed.Bytes()
+		half := len(data) / 2
+
+		rawPayload, err := stream.Read(bytes.NewReader(data[:half]))
+		require.NoError(t, err)
+		assert.Nil(t, rawPayload)
+
+		rawPayload, err = stream.Read(bytes.NewReader(data[half:]))
+		require.NoError(t, err)
+		assert.JSONEq(t, payload, string(rawPayload))
+	}
+}
+
+func TestZlibStream_ReadETF(t *testing.T) {
+	payloads := []string{
+		`{"op":10,"d":{"heartbeat_interval":41250}}`,
+		`{"op":11,"d":null}`,
+		`{"op":0,"s":1,"t":"MESSAGE_CREATE","d":{"id":"123","content":"hello","mentions":[],"tts":false}}`,
+	}
+
+	compressed := new(bytes.Buffer)
+	writer := zlib.NewWriter(compressed)
+
+	stream := newZlibStream(EncodingETF)
+	defer stream.Close()
+
+	for _, payload := range payloads {
+		data, err := etf.FromJSON([]byte(payload))
+		require.NoError(t, err)
+
+		compressed.Reset()
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Flush())
+
+		// split each payload into two websocket messages
+		data = compressed.Bytes()
+		half := len(data) / 2
+
+		rawPayload, err := stream.Read(bytes.NewReader(data[:half]))
+		require.NoError(t, err)
+		assert.Nil(t, rawPayload)
+
+		rawPayload, err = stream.Read(bytes.NewReader(data[half:]))
+		require.NoError(t, err)
+		assert.JSONEq(t, payload, string(rawPayload))
+	}
+}
+
+func TestGateway_ZlibStreamErrorReconnects(t *testing.T) {
+	var connections int32
+	commands := make(chan Opcode, 8)
+	var resumeURL string
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		connection := atomic.AddInt32(&connections, 1)
+
+		// every connection has its own deflate context
+		compressed := new(bytes.Buffer)
+		writer := zlib.NewWriter(compressed)
+		send := func(payload string) {
+			compressed.Reset()
+			_, _ = writer.Write([]byte(payload))
+			_ = writer.Flush()
+			_ = conn.WriteMessage(websocket.BinaryMessage, compressed.Bytes())
+		}
+
+		send(`{"op":10,"d":{"heartbeat_interval":41250}}`)
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var message struct {
+				Op Opcode `json:"op"`
+			}
+			if err = json.Unmarshal(data, &message); err != nil {
+				return
+			}
+			commands <- message.Op
+			if message.Op == OpcodeIdentify && connection == 1 {
+				send(`{"op":0,"s":1,"t":"READY","d":{"v":10,"user":{"id":"1","username":"bot"},"guilds":[],"session_id":"session","resume_gateway_url":"` + resumeURL + `","application":{"id":"1"}}}`)
+				// a deflate block with an invalid block type
+				_ = conn.WriteMessage(websocket.BinaryMessage, []byte{0xDE, 0xAD, 0xBE, 0xEF, 0x00, 0x00, 0xFF, 0xFF})
+			}
+		}
+	}))
+	defer server.Close()
+	resumeURL = "ws" + strings.TrimPrefix(server.URL, "http")
+
+	g := New("token", func(EventType, int, int, EventData) {}, nil,
+		WithURL(resumeURL),
+		WithTransportCompression(true),
+	)
+	require.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	for _, expected := range []Opcode{OpcodeIdentify, OpcodeResume} {
+		select {
+		case op := <-commands:
+			assert.Equal(t, expected, op)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for opcode %d", expected)
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&connections))
+	assert.Equal(t, "session", *g.SessionID())
+}
@@ -0,0 +1,75 @@
+// Package handler provides a Router which routes interactions to handlers based on paths.
+//
+// Application commands and autocomplete interactions are routed by their command path:
+//
+//	/command
+//	/command/subcommand
+//	/command/subcommand-group/subcommand
+//
+// Component & modal submit interactions are routed by their custom ID, which should be a path as well.
+// Path segments wrapped in curly braces are variables which are available in the Vars of the event:
+//
+//	/ticket/{id}/close
+//
+// Routes can be grouped and share Middleware(s) which are executed in the order they were added before the handler.
+package handler
+
+import (
+	"github.com/disgoorg/disgo/events"
+)
+
+type (
+	// CommandHandler handles application command interactions.
+	CommandHandler func(e *CommandEvent) error
+
+	// AutocompleteHandler handles autocomplete interactions.
+	AutocompleteHandler func(e *AutocompleteEvent) error
+
+	// ComponentHandler handles component interactions.
+	ComponentHandler func(e *ComponentEvent) error
+
+	// ModalHandler handles modal submit interactions.
+	ModalHandler func(e *ModalEvent) error
+
+	// InteractionHandler handles any kind of interaction. It is the handler wrapped by Middleware(s).
+	InteractionHandler func(e *InteractionEvent) error
+
+	// Middleware wraps an InteractionHandler. Middleware(s) can stop the handling of an interaction by not calling the next InteractionHandler.
+	Middleware func(next InteractionHandler) InteractionHandler
+
+	// ErrorHandler is called when a handler or Middleware returned an error.
+	ErrorHandler func(e *InteractionEvent, err error)
+)
+
+// InteractionEvent is the event passed to Middleware(s) and the NotFound & Error handlers.
+type InteractionEvent struct {
+	*events.InteractionCreate
+	// Path is the path the interaction was routed by.
+	Path string
+	// Vars contains the values of the path variables of the matched route.
+	Vars map[string]string
+}
+
+// CommandEvent is the event passed to CommandHandler(s).
+type CommandEvent struct {
+	*events.ApplicationCommandInteractionCreate
+	Vars map[string]string
+}
+
+// AutocompleteEvent is the event passed to AutocompleteHandler(s).
+type AutocompleteEvent struct {
+	*events.AutocompleteInteractionCreate
+	Vars map[string]string
+}
+
+// ComponentEvent is the event passed to ComponentHandler(s).
+type ComponentEvent struct {
+	*events.ComponentInteractionCreate
+	Vars map[string]string
+}
+
+// ModalEvent is the event passed to ModalHandler(s).
+type ModalEvent struct {
+	*events.ModalSubmitInteractionCreate
+	Vars map[string]string
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/handler"
+)
+
+// Logger logs the path, user and duration of every handled interaction with the bot.Client logger at debug level.
+func Logger(next handler.InteractionHandler) handler.InteractionHandler {
+	return func(e *handler.InteractionEvent) error {
+		start := time.Now()
+		err := next(e)
+		e.Client().Logger().Debugf("handled interaction %s of type %d with path %s from user %s in %s", e.ID(), e.Type(), e.Path, e.User().ID, time.Since(start))
+		return err
+	}
+}
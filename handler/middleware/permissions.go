@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/handler"
+)
+
+// ErrMissingPermissions is returned by RequirePermissions when the member is missing permissions or the interaction did not happen in a guild.
+var ErrMissingPermissions = errors.New("missing permissions")
+
+// RequirePermissions only calls the following handlers if the member who created the interaction has all the given permissions.
+// Otherwise, ErrMissingPermissions is returned, which can be handled in the handler.ErrorHandler of the handler.Router.
+func RequirePermissions(permissions discord.Permissions) handler.Middleware {
+	return func(next handler.InteractionHandler) handler.InteractionHandler {
+		return func(e *handler.InteractionEvent) error {
+			if member := e.Member(); member == nil || !member.Permissions.Has(permissions) {
+				return ErrMissingPermissions
+			}
+			return next(e)
+		}
+	}
+}
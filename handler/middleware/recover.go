@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/disgoorg/disgo/handler"
+)
+
+// Recover recovers from panics in the following handlers and returns them as error instead.
+func Recover(next handler.InteractionHandler) handler.InteractionHandler {
+	return func(e *handler.InteractionEvent) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("recovered from panic in interaction handler: %+v\nstack: %s", r, debug.Stack())
+			}
+		}()
+		return next(e)
+	}
+}
@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+)
+
+// Router routes interactions to handlers based on their path. See the package documentation for more information.
+// Register all routes before adding the Router as bot.EventListener.
+type Router interface {
+	bot.EventListener
+
+	// Use appends the given Middleware(s) to the Router. They apply to all routes of the Router and its sub Router(s).
+	Use(middlewares ...Middleware)
+
+	// With returns a new inline Router with the given Middleware(s) appended.
+	With(middlewares ...Middleware) Router
+
+	// Group creates a new inline Router, which shares the path prefix of this Router, and passes it to the given function.
+	Group(fn func(r Router))
+
+	// Route creates a new sub Router with the given path prefix, passes it to the given function and returns it.
+	Route(pattern string, fn func(r Router)) Router
+
+	// Command registers a CommandHandler for the given pattern.
+	Command(pattern string, h CommandHandler)
+
+	// Autocomplete registers an AutocompleteHandler for the given pattern.
+	Autocomplete(pattern string, h AutocompleteHandler)
+
+	// Component registers a ComponentHandler for the given custom ID pattern.
+	Component(pattern string, h ComponentHandler)
+
+	// Modal registers a ModalHandler for the given custom ID pattern.
+	Modal(pattern string, h ModalHandler)
+
+	// NotFound sets the InteractionHandler which is called when no route matches an interaction.
+	NotFound(h InteractionHandler)
+
+	// Error sets the ErrorHandler which is called when a handler or Middleware returned an error.
+	// By default, errors are logged with the bot.Client logger.
+	Error(h ErrorHandler)
+}
+
+// New returns a new Router.
+func New() Router {
+	return &routerImpl{
+		routes: &routes{},
+	}
+}
+
+type routeType int
+
+const (
+	routeTypeCommand routeType = iota
+	routeTypeAutocomplete
+	routeTypeComponent
+	routeTypeModal
+)
+
+type route struct {
+	routeType routeType
+	segments  []string
+	router    *routerImpl
+	handle    func(event bot.Event, vars map[string]string) error
+}
+
+// routes is shared between a Router and all its sub Router(s).
+type routes struct {
+	mu           sync.RWMutex
+	routes       []route
+	notFound     InteractionHandler
+	errorHandler ErrorHandler
+}
+
+type routerImpl struct {
+	routes      *routes
+	parent      *routerImpl
+	prefix      string
+	middlewares []Middleware
+}
+
+func (r *routerImpl) Use(middlewares ...Middleware) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+func (r *routerImpl) With(middlewares ...Middleware) Router {
+	router := r.sub(r.prefix)
+	router.middlewares = middlewares
+	return router
+}
+
+func (r *routerImpl) Group(fn func(r Router)) {
+	fn(r.sub(r.prefix))
+}
+
+func (r *routerImpl) Route(pattern string, fn func(r Router)) Router {
+	router := r.sub(joinPath(r.prefix, pattern))
+	fn(router)
+	return router
+}
+
+func (r *routerImpl) sub(prefix string) *routerImpl {
+	return &routerImpl{
+		routes: r.routes,
+		parent: r,
+		prefix: prefix,
+	}
+}
+
+func (r *routerImpl) Command(pattern string, h CommandHandler) {
+	r.handle(routeTypeCommand, pattern, func(event bot.Event, vars map[string]string) error {
+		return h(&CommandEvent{
+			ApplicationCommandInteractionCreate: event.(*events.ApplicationCommandInteractionCreate),
+			Vars:                                vars,
+		})
+	})
+}
+
+func (r *routerImpl) Autocomplete(pattern string, h AutocompleteHandler) {
+	r.handle(routeTypeAutocomplete, pattern, func(event bot.Event, vars map[string]string) error {
+		return h(&AutocompleteEvent{
+			AutocompleteInteractionCreate: event.(*events.AutocompleteInteractionCreate),
+			Vars:                          vars,
+		})
+	})
+}
+
+func (r *routerImpl) Component(pattern string, h ComponentHandler) {
+	r.handle(routeTypeComponent, pattern, func(event bot.Event, vars map[string]string) error {
+		return h(&ComponentEvent{
+			ComponentInteractionCreate: event.(*events.ComponentInteractionCreate),
+			Vars:                       vars,
+		})
+	})
+}
+
+func (r *routerImpl) Modal(pattern string, h ModalHandler) {
+	r.handle(routeTypeModal, pattern, func(event bot.Event, vars map[string]string) error {
+		return h(&ModalEvent{
+			ModalSubmitInteractionCreate: event.(*events.ModalSubmitInteractionCreate),
+			Vars:                         vars,
+		})
+	})
+}
+
+func (r *routerImpl) handle(routeType routeType, pattern string, handle func(event bot.Event, vars map[string]string) error) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.routes = append(r.routes.routes, route{
+		routeType: routeType,
+		segments:  splitPath(joinPath(r.prefix, pattern)),
+		router:    r,
+		handle:    handle,
+	})
+}
+
+func (r *routerImpl) NotFound(h InteractionHandler) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.notFound = h
+}
+
+func (r *routerImpl) Error(h ErrorHandler) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+	r.routes.errorHandler = h
+}
+
+func (r *routerImpl) OnEvent(event bot.Event) {
+	var (
+		routeType   routeType
+		path        string
+		interaction *events.InteractionCreate
+	)
+	switch e := event.(type) {
+	case *events.ApplicationCommandInteractionCreate:
+		routeType = routeTypeCommand
+		path = commandPath(e.Data)
+		interaction = &events.InteractionCreate{GenericEvent: e.GenericEvent, Interaction: e.ApplicationCommandInteraction, Respond: e.Respond}
+
+	case *events.AutocompleteInteractionCreate:
+		routeType = routeTypeAutocomplete
+		path = joinCommandPath(e.Data.CommandName, e.Data.SubCommandGroupName, e.Data.SubCommandName)
+		interaction = &events.InteractionCreate{GenericEvent: e.GenericEvent, Interaction: e.AutocompleteInteraction, Respond: e.Respond}
+
+	case *events.ComponentInteractionCreate:
+		routeType = routeTypeComponent
+		path = e.Data.CustomID()
+		interaction = &events.InteractionCreate{GenericEvent: e.GenericEvent, Interaction: e.ComponentInteraction, Respond: e.Respond}
+
+	case *events.ModalSubmitInteractionCreate:
+		routeType = routeTypeModal
+		path = e.Data.CustomID
+		interaction = &events.InteractionCreate{GenericEvent: e.GenericEvent, Interaction: e.ModalSubmitInteraction, Respond: e.Respond}
+
+	default:
+		return
+	}
+
+	r.routes.mu.RLock()
+	var (
+		matched *route
+		vars    map[string]string
+	)
+	segments := splitPath(path)
+	for i := range r.routes.routes {
+		if r.routes.routes[i].routeType != routeType {
+			continue
+		}
+		var ok bool
+		if vars, ok = matchPath(r.routes.routes[i].segments, segments); ok {
+			matched = &r.routes.routes[i]
+			break
+		}
+	}
+	notFound := r.routes.notFound
+	errorHandler := r.routes.errorHandler
+	r.routes.mu.RUnlock()
+
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	e := &InteractionEvent{
+		InteractionCreate: interaction,
+		Path:              path,
+		Vars:              vars,
+	}
+
+	var handler InteractionHandler
+	if matched != nil {
+		handler = matched.router.chain(func(e *InteractionEvent) error {
+			return matched.handle(event, e.Vars)
+		})
+	} else if notFound != nil {
+		handler = r.chain(notFound)
+	} else {
+		return
+	}
+
+	if err := handler(e); err != nil {
+		if errorHandler != nil {
+			errorHandler(e, err)
+			return
+		}
+		e.Client().Logger().Errorf("error while handling interaction with path %s: %s", e.Path, err)
+	}
+}
+
+// chain wraps the given InteractionHandler with the Middleware(s) of this Router and all its parents.
+func (r *routerImpl) chain(handler InteractionHandler) InteractionHandler {
+	r.routes.mu.RLock()
+	defer r.routes.mu.RUnlock()
+	for router := r; router != nil; router = router.parent {
+		for i := len(router.middlewares) - 1; i >= 0; i-- {
+			handler = router.middlewares[i](handler)
+		}
+	}
+	return handler
+}
+
+func commandPath(data discord.ApplicationCommandInteractionData) string {
+	if slashData, ok := data.(discord.SlashCommandInteractionData); ok {
+		return joinCommandPath(slashData.CommandName(), slashData.SubCommandGroupName, slashData.SubCommandName)
+	}
+	return "/" + data.CommandName()
+}
+
+func joinCommandPath(commandName string, subCommandGroupName *string, subCommandName *string) string {
+	path := "/" + commandName
+	if subCommandGroupName != nil {
+		path += "/" + *subCommandGroupName
+	}
+	if subCommandName != nil {
+		path += "/" + *subCommandName
+	}
+	return path
+}
+
+func joinPath(prefix string, pattern string) string {
+	return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(pattern, "/")
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// matchPath matches the given path segments against the pattern segments and returns the values of the path variables.
+func matchPath(pattern []string, path []string) (map[string]string, bool) {
+	if len(pattern) != len(path) {
+		return nil, false
+	}
+	var vars map[string]string
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if vars == nil {
+				vars = make(map[string]string)
+			}
+			vars[segment[1:len(segment)-1]] = path[i]
+			continue
+		}
+		if segment != path[i] {
+			return nil, false
+		}
+	}
+	return vars, true
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPath(t *testing.T) {
+	vars, ok := matchPath(splitPath("/ticket/{id}/close"), splitPath("/ticket/123/close"))
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"id": "123"}, vars)
+
+	_, ok = matchPath(splitPath("/ticket/{id}/close"), splitPath("/ticket/123/open"))
+	assert.False(t, ok)
+
+	_, ok = matchPath(splitPath("/ticket/{id}"), splitPath("/ticket/123/close"))
+	assert.False(t, ok)
+}
+
+func TestRouter(t *testing.T) {
+	var calls []string
+	middleware := func(name string) Middleware {
+		return func(next InteractionHandler) InteractionHandler {
+			return func(e *InteractionEvent) error {
+				calls = append(calls, name)
+				return next(e)
+			}
+		}
+	}
+
+	router := New()
+	router.Use(middleware("root"))
+	router.Route("/ticket", func(r Router) {
+		r.Use(middleware("ticket"))
+		r.With(middleware("close")).Component("/{id}/close", func(e *ComponentEvent) error {
+			calls = append(calls, "close "+e.Vars["id"])
+			return nil
+		})
+		r.Modal("/{id}/reason", func(e *ModalEvent) error {
+			return errors.New("failed")
+		})
+	})
+
+	var handledErr error
+	router.Error(func(e *InteractionEvent, err error) {
+		handledErr = err
+	})
+
+	var interaction discord.ComponentInteraction
+	err := json.Unmarshal([]byte(`{"id":"1","type":3,"data":{"component_type":2,"custom_id":"/ticket/42/close"}}`), &interaction)
+	assert.NoError(t, err)
+	router.OnEvent(&events.ComponentInteractionCreate{
+		ComponentInteraction: interaction,
+	})
+	assert.Equal(t, []string{"root", "ticket", "close", "close 42"}, calls)
+
+	calls = nil
+	router.OnEvent(&events.ModalSubmitInteractionCreate{
+		ModalSubmitInteraction: discord.ModalSubmitInteraction{Data: discord.ModalSubmitInteractionData{CustomID: "/ticket/42/reason"}},
+	})
+	assert.Equal(t, []string{"root", "ticket"}, calls)
+	assert.EqualError(t, handledErr, "failed")
+
+	// no route & no not found handler
+	calls = nil
+	router.OnEvent(&events.ModalSubmitInteractionCreate{
+		ModalSubmitInteraction: discord.ModalSubmitInteraction{Data: discord.ModalSubmitInteractionData{CustomID: "/unknown"}},
+	})
+	assert.Empty(t, calls)
+}
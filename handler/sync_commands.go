@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// CommandUpdate is an existing discord.ApplicationCommand which differs from the desired discord.ApplicationCommandCreate.
+type CommandUpdate struct {
+	Old discord.ApplicationCommand
+	New discord.ApplicationCommandCreate
+}
+
+// CommandsDiff describes the changes needed to go from the existing commands to the desired commands.
+// Commands are matched by their type and name.
+type CommandsDiff struct {
+	Create    []discord.ApplicationCommandCreate
+	Update    []CommandUpdate
+	Delete    []discord.ApplicationCommand
+	Unchanged []discord.ApplicationCommand
+}
+
+// HasChanges returns whether any command needs to be created, updated or deleted.
+func (d CommandsDiff) HasChanges() bool {
+	return len(d.Create) > 0 || len(d.Update) > 0 || len(d.Delete) > 0
+}
+
+func (d CommandsDiff) String() string {
+	names := func(n int, name func(i int) string) string {
+		s := make([]string, n)
+		for i := range s {
+			s[i] = name(i)
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	}
+	return fmt.Sprintf("create: %s, update: %s, delete: %s, unchanged: %d",
+		names(len(d.Create), func(i int) string { return d.Create[i].CommandName() }),
+		names(len(d.Update), func(i int) string { return d.Update[i].Old.Name() }),
+		names(len(d.Delete), func(i int) string { return d.Delete[i].Name() }),
+		len(d.Unchanged),
+	)
+}
+
+// DiffCommands structurally compares the existing commands with the desired commands and returns the resulting CommandsDiff.
+// Everything settable on creation is compared: names, descriptions, localizations, options, default member permissions, dm permission & nsfw.
+// A null default member permissions (everyone can use the command) differs from a 0 (only administrators can use the command).
+// The dm permission is only compared for global commands.
+func DiffCommands(existing []discord.ApplicationCommand, commands []discord.ApplicationCommandCreate) (CommandsDiff, error) {
+	var diff CommandsDiff
+
+	existingCommands := make(map[commandKey]discord.ApplicationCommand, len(existing))
+	for _, command := range existing {
+		existingCommands[commandKey{commandType: command.Type(), name: command.Name()}] = command
+	}
+
+	for _, command := range commands {
+		key := commandKey{commandType: command.Type(), name: command.CommandName()}
+		old, ok := existingCommands[key]
+		if !ok {
+			diff.Create = append(diff.Create, command)
+			continue
+		}
+		delete(existingCommands, key)
+
+		equal, err := commandsEqual(old, command)
+		if err != nil {
+			return diff, err
+		}
+		if equal {
+			diff.Unchanged = append(diff.Unchanged, old)
+			continue
+		}
+		diff.Update = append(diff.Update, CommandUpdate{Old: old, New: command})
+	}
+
+	// keep the order in which discord returned the commands
+	for _, command := range existing {
+		if _, ok := existingCommands[commandKey{commandType: command.Type(), name: command.Name()}]; ok {
+			diff.Delete = append(diff.Delete, command)
+		}
+	}
+	return diff, nil
+}
+
+// SyncGlobalCommands fetches the existing global commands, compares them with the given commands and only creates, updates or deletes the commands which changed.
+// Unlike rest.Applications.SetGlobalCommands this keeps the IDs & permission settings of unchanged or updated commands and does not count unchanged commands towards the daily command create limit.
+func SyncGlobalCommands(client rest.Applications, applicationID snowflake.ID, commands []discord.ApplicationCommandCreate, opts ...rest.RequestOpt) (CommandsDiff, error) {
+	existing, err := client.GetGlobalCommands(applicationID, true, opts...)
+	if err != nil {
+		return CommandsDiff{}, err
+	}
+	return syncCommands(existing, commands,
+		func(command discord.ApplicationCommandCreate) error {
+			_, err := client.CreateGlobalCommand(applicationID, command, opts...)
+			return err
+		},
+		func(commandID snowflake.ID, command discord.ApplicationCommandUpdate) error {
+			_, err := client.UpdateGlobalCommand(applicationID, commandID, command, opts...)
+			return err
+		},
+		func(commandID snowflake.ID) error {
+			return client.DeleteGlobalCommand(applicationID, commandID, opts...)
+		},
+	)
+}
+
+// SyncGuildCommands works like SyncGlobalCommands but for the commands of the given guild.
+func SyncGuildCommands(client rest.Applications, applicationID snowflake.ID, guildID snowflake.ID, commands []discord.ApplicationCommandCreate, opts ...rest.RequestOpt) (CommandsDiff, error) {
+	existing, err := client.GetGuildCommands(applicationID, guildID, true, opts...)
+	if err != nil {
+		return CommandsDiff{}, err
+	}
+	return syncCommands(existing, commands,
+		func(command discord.ApplicationCommandCreate) error {
+			_, err := client.CreateGuildCommand(applicationID, guildID, command, opts...)
+			return err
+		},
+		func(commandID snowflake.ID, command discord.ApplicationCommandUpdate) error {
+			_, err := client.UpdateGuildCommand(applicationID, guildID, commandID, command, opts...)
+			return err
+		},
+		func(commandID snowflake.ID) error {
+			return client.DeleteGuildCommand(applicationID, guildID, commandID, opts...)
+		},
+	)
+}
+
+func syncCommands(existing []discord.ApplicationCommand, commands []discord.ApplicationCommandCreate,
+	create func(command discord.ApplicationCommandCreate) error,
+	update func(commandID snowflake.ID, command discord.ApplicationCommandUpdate) error,
+	del func(commandID snowflake.ID) error,
+) (CommandsDiff, error) {
+	diff, err := DiffCommands(existing, commands)
+	if err != nil {
+		return diff, err
+	}
+
+	// delete first to not run into the command limit
+	for _, command := range diff.Delete {
+		if err = del(command.ID()); err != nil {
+			return diff, fmt.Errorf("failed to delete command %s: %w", command.Name(), err)
+		}
+	}
+	for _, command := range diff.Update {
+		commandUpdate, err := toCommandUpdate(command.New)
+		if err != nil {
+			return diff, err
+		}
+		if err = update(command.Old.ID(), commandUpdate); err != nil {
+			return diff, fmt.Errorf("failed to update command %s: %w", command.Old.Name(), err)
+		}
+	}
+	for _, command := range diff.Create {
+		if err = create(command); err != nil {
+			return diff, fmt.Errorf("failed to create command %s: %w", command.CommandName(), err)
+		}
+	}
+	return diff, nil
+}
+
+type commandKey struct {
+	commandType discord.ApplicationCommandType
+	name        string
+}
+
+// comparableCommand holds all fields of a command which can be set on creation.
+type comparableCommand struct {
+	Name                     string                                      `json:"name"`
+	NameLocalizations        map[discord.Locale]string                   `json:"name_localizations"`
+	Description              string                                      `json:"description"`
+	DescriptionLocalizations map[discord.Locale]string                   `json:"description_localizations"`
+	Options                  []discord.UnmarshalApplicationCommandOption `json:"options"`
+	DefaultMemberPermissions json.RawMessage                             `json:"default_member_permissions"`
+	DMPermission             *bool                                       `json:"dm_permission"`
+	NSFW                     bool                                        `json:"nsfw"`
+}
+
+func newComparableCommand(v json.Marshaler) (comparableCommand, error) {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return comparableCommand{}, err
+	}
+	var command comparableCommand
+	err = json.Unmarshal(data, &command)
+	return command, err
+}
+
+// defaultMemberPermissions returns the default member permissions and whether they are null or omitted.
+func (c comparableCommand) defaultMemberPermissions() (discord.Permissions, bool, error) {
+	if len(c.DefaultMemberPermissions) == 0 || bytes.Equal(c.DefaultMemberPermissions, json.NullBytes) {
+		return 0, true, nil
+	}
+	var permissions discord.Permissions
+	err := json.Unmarshal(c.DefaultMemberPermissions, &permissions)
+	return permissions, false, err
+}
+
+// dmPermission returns the dm permission & defaults to true if it was omitted.
+func (c comparableCommand) dmPermission() bool {
+	return c.DMPermission == nil || *c.DMPermission
+}
+
+func (c comparableCommand) options() []discord.ApplicationCommandOption {
+	if len(c.Options) == 0 {
+		return nil
+	}
+	options := make([]discord.ApplicationCommandOption, len(c.Options))
+	for i := range c.Options {
+		options[i] = c.Options[i].ApplicationCommandOption
+	}
+	return options
+}
+
+func commandsEqual(old discord.ApplicationCommand, command discord.ApplicationCommandCreate) (bool, error) {
+	oldCommand, err := newComparableCommand(old)
+	if err != nil {
+		return false, err
+	}
+	newCommand, err := newComparableCommand(command)
+	if err != nil {
+		return false, err
+	}
+
+	if oldCommand.Name != newCommand.Name ||
+		oldCommand.Description != newCommand.Description ||
+		oldCommand.NSFW != newCommand.NSFW ||
+		!localizationsEqual(oldCommand.NameLocalizations, newCommand.NameLocalizations) ||
+		!localizationsEqual(oldCommand.DescriptionLocalizations, newCommand.DescriptionLocalizations) {
+		return false, nil
+	}
+
+	// dm permission only applies to global commands
+	if old.GuildID() == nil && oldCommand.dmPermission() != newCommand.dmPermission() {
+		return false, nil
+	}
+
+	oldPermissions, oldNull, err := oldCommand.defaultMemberPermissions()
+	if err != nil {
+		return false, err
+	}
+	newPermissions, newNull, err := newCommand.defaultMemberPermissions()
+	if err != nil {
+		return false, err
+	}
+	if oldNull != newNull || oldPermissions != newPermissions {
+		return false, nil
+	}
+
+	oldOptions, err := json.Marshal(oldCommand.options())
+	if err != nil {
+		return false, err
+	}
+	newOptions, err := json.Marshal(newCommand.options())
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(oldOptions, newOptions), nil
+}
+
+func localizationsEqual(a map[discord.Locale]string, b map[discord.Locale]string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toCommandUpdate converts the given discord.ApplicationCommandCreate to a discord.ApplicationCommandUpdate which sets all fields.
+// This makes sure fields which were removed from the discord.ApplicationCommandCreate are reset to their default.
+func toCommandUpdate(command discord.ApplicationCommandCreate) (discord.ApplicationCommandUpdate, error) {
+	c, err := newComparableCommand(command)
+	if err != nil {
+		return nil, err
+	}
+
+	nameLocalizations := c.NameLocalizations
+	if nameLocalizations == nil {
+		nameLocalizations = map[discord.Locale]string{}
+	}
+	permissions, null, err := c.defaultMemberPermissions()
+	if err != nil {
+		return nil, err
+	}
+	defaultMemberPermissions := json.NewNullablePtr(permissions)
+	if null {
+		defaultMemberPermissions = json.NullPtr[discord.Permissions]()
+	}
+	dmPermission := c.dmPermission()
+	nsfw := c.NSFW
+
+	switch command.Type() {
+	case discord.ApplicationCommandTypeSlash:
+		descriptionLocalizations := c.DescriptionLocalizations
+		if descriptionLocalizations == nil {
+			descriptionLocalizations = map[discord.Locale]string{}
+		}
+		options := c.options()
+		if options == nil {
+			options = []discord.ApplicationCommandOption{}
+		}
+		return discord.SlashCommandUpdate{
+			Name:                     &c.Name,
+			NameLocalizations:        &nameLocalizations,
+			Description:              &c.Description,
+			DescriptionLocalizations: &descriptionLocalizations,
+			Options:                  &options,
+			DefaultMemberPermissions: defaultMemberPermissions,
+			DMPermission:             &dmPermission,
+			NSFW:                     &nsfw,
+		}, nil
+
+	case discord.ApplicationCommandTypeUser:
+		return discord.UserCommandUpdate{
+			Name:                     &c.Name,
+			NameLocalizations:        &nameLocalizations,
+			DefaultMemberPermissions: defaultMemberPermissions,
+			DMPermission:             &dmPermission,
+			NSFW:                     &nsfw,
+		}, nil
+
+	case discord.ApplicationCommandTypeMessage:
+		return discord.MessageCommandUpdate{
+			Name:                     &c.Name,
+			NameLocalizations:        &nameLocalizations,
+			DefaultMemberPermissions: defaultMemberPermissions,
+			DMPermission:             &dmPermission,
+			NSFW:                     &nsfw,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown application command type %d", command.Type())
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCommands(t *testing.T) {
+	var unmarshalCommands []discord.UnmarshalApplicationCommand
+	err := json.Unmarshal([]byte(`[
+		{"id":"1","type":1,"name":"ping","description":"Ping","dm_permission":true,"default_member_permissions":null,"version":"1"},
+		{"id":"2","type":1,"name":"ban","description":"Ban","dm_permission":false,"default_member_permissions":"4","version":"1",
+			"options":[{"type":6,"name":"user","description":"User","required":true}]},
+		{"id":"3","type":2,"name":"info","dm_permission":true,"version":"1"},
+		{"id":"4","type":1,"name":"old","description":"Old","dm_permission":true,"version":"1"}
+	]`), &unmarshalCommands)
+	assert.NoError(t, err)
+
+	existing := make([]discord.ApplicationCommand, len(unmarshalCommands))
+	for i := range unmarshalCommands {
+		existing[i] = unmarshalCommands[i].ApplicationCommand
+	}
+
+	diff, err := DiffCommands(existing, []discord.ApplicationCommandCreate{
+		discord.SlashCommandCreate{
+			Name:        "ping",
+			Description: "Ping",
+		},
+		discord.SlashCommandCreate{
+			Name:                     "ban",
+			Description:              "Ban",
+			DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionBanMembers),
+			DMPermission:             json.Ptr(false),
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionUser{Name: "user", Description: "User"},
+			},
+		},
+		discord.UserCommandCreate{
+			Name:              "info",
+			NameLocalizations: map[discord.Locale]string{discord.LocaleGerman: "Info"},
+		},
+		discord.MessageCommandCreate{
+			Name: "report",
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, diff.Create, 1)
+	assert.Equal(t, "report", diff.Create[0].CommandName())
+
+	assert.Len(t, diff.Update, 2)
+	assert.Equal(t, "ban", diff.Update[0].Old.Name())
+	assert.Equal(t, "info", diff.Update[1].Old.Name())
+
+	assert.Len(t, diff.Delete, 1)
+	assert.Equal(t, "old", diff.Delete[0].Name())
+
+	assert.Len(t, diff.Unchanged, 1)
+	assert.Equal(t, "ping", diff.Unchanged[0].Name())
+
+	assert.True(t, diff.HasChanges())
+	assert.Equal(t, "create: [report], update: [ban, info], delete: [old], unchanged: 1", diff.String())
+}
+
+func TestDiffCommandsNullDefaultMemberPermissions(t *testing.T) {
+	var unmarshalCommands []discord.UnmarshalApplicationCommand
+	err := json.Unmarshal([]byte(`[
+		{"id":"1","type":1,"name":"open","description":"Open","dm_permission":true,"default_member_permissions":null,"version":"1"},
+		{"id":"2","type":1,"name":"admin","description":"Admin","dm_permission":true,"default_member_permissions":"0","version":"1"}
+	]`), &unmarshalCommands)
+	assert.NoError(t, err)
+
+	existing := make([]discord.ApplicationCommand, len(unmarshalCommands))
+	for i := range unmarshalCommands {
+		existing[i] = unmarshalCommands[i].ApplicationCommand
+	}
+
+	// open becomes admin only & admin becomes open
+	diff, err := DiffCommands(existing, []discord.ApplicationCommandCreate{
+		discord.SlashCommandCreate{
+			Name:                     "open",
+			Description:              "Open",
+			DefaultMemberPermissions: json.NewNullablePtr(discord.Permissions(0)),
+		},
+		discord.SlashCommandCreate{
+			Name:        "admin",
+			Description: "Admin",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, diff.Update, 2)
+	assert.Empty(t, diff.Unchanged)
+}
+
+func TestToCommandUpdate(t *testing.T) {
+	update, err := toCommandUpdate(discord.SlashCommandCreate{Name: "ping", Description: "Ping"})
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(update)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":1,"name":"ping","name_localizations":{},"description":"Ping","description_localizations":{},"options":[],"default_member_permissions":null,"dm_permission":true,"nsfw":false}`, string(data))
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"time"
 
 	"github.com/disgoorg/disgo/bot"
@@ -9,13 +11,13 @@ import (
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerChannelCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelCreate) {
+func gatewayHandlerChannelCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelCreate) {
 	client.Caches().Channels().Put(event.ID(), event.Channel)
 
 	if guildChannel, ok := event.Channel.(discord.GuildChannel); ok {
 		client.EventManager().DispatchEvent(&events.GuildChannelCreate{
 			GenericGuildChannel: &events.GenericGuildChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      guildChannel,
 				GuildID:      guildChannel.GuildID(),
@@ -24,7 +26,7 @@ func gatewayHandlerChannelCreate(client bot.Client, sequenceNumber int, shardID
 	} else if dmChannel, ok := event.Channel.(discord.DMChannel); ok {
 		client.EventManager().DispatchEvent(&events.DMChannelCreate{
 			GenericDMChannel: &events.GenericDMChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      dmChannel,
 			},
@@ -32,14 +34,14 @@ func gatewayHandlerChannelCreate(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerChannelUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelUpdate) {
+func gatewayHandlerChannelUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelUpdate) {
 	if guildChannel, ok := event.Channel.(discord.GuildChannel); ok {
 		oldGuildChannel, _ := client.Caches().Channels().GetGuildChannel(event.ID())
 		client.Caches().Channels().Put(event.ID(), event.Channel)
 
 		client.EventManager().DispatchEvent(&events.GuildChannelUpdate{
 			GenericGuildChannel: &events.GenericGuildChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      guildChannel,
 				GuildID:      guildChannel.GuildID(),
@@ -55,7 +57,7 @@ func gatewayHandlerChannelUpdate(client bot.Client, sequenceNumber int, shardID
 					client.Caches().Channels().Remove(guildThread.ID())
 					client.EventManager().DispatchEvent(&events.ThreadHide{
 						GenericThread: &events.GenericThread{
-							GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+							GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 							Thread:       guildThread,
 							ThreadID:     guildThread.ID(),
 							GuildID:      guildThread.GuildID(),
@@ -72,7 +74,7 @@ func gatewayHandlerChannelUpdate(client bot.Client, sequenceNumber int, shardID
 
 		client.EventManager().DispatchEvent(&events.DMChannelUpdate{
 			GenericDMChannel: &events.GenericDMChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      dmChannel,
 			},
@@ -81,13 +83,13 @@ func gatewayHandlerChannelUpdate(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerChannelDelete(client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelDelete) {
+func gatewayHandlerChannelDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelDelete) {
 	client.Caches().Channels().Remove(event.ID())
 
 	if guildChannel, ok := event.Channel.(discord.GuildChannel); ok {
 		client.EventManager().DispatchEvent(&events.GuildChannelDelete{
 			GenericGuildChannel: &events.GenericGuildChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      guildChannel,
 				GuildID:      guildChannel.GuildID(),
@@ -96,7 +98,7 @@ func gatewayHandlerChannelDelete(client bot.Client, sequenceNumber int, shardID
 	} else if dmChannel, ok := event.Channel.(discord.DMChannel); ok {
 		client.EventManager().DispatchEvent(&events.DMChannelDelete{
 			GenericDMChannel: &events.GenericDMChannel{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				ChannelID:    event.ID(),
 				Channel:      dmChannel,
 			},
@@ -104,7 +106,7 @@ func gatewayHandlerChannelDelete(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerChannelPinsUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelPinsUpdate) {
+func gatewayHandlerChannelPinsUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventChannelPinsUpdate) {
 	var oldTime *time.Time
 	channel, ok := client.Caches().Channels().GetMessageChannel(event.ChannelID)
 	if ok {
@@ -115,14 +117,14 @@ func gatewayHandlerChannelPinsUpdate(client bot.Client, sequenceNumber int, shar
 
 	if event.GuildID == nil {
 		client.EventManager().DispatchEvent(&events.DMChannelPinsUpdate{
-			GenericEvent:        events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent:        events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			ChannelID:           event.ChannelID,
 			OldLastPinTimestamp: oldTime,
 			NewLastPinTimestamp: event.LastPinTimestamp,
 		})
 	} else {
 		client.EventManager().DispatchEvent(&events.GuildChannelPinsUpdate{
-			GenericEvent:        events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent:        events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			GuildID:             *event.GuildID,
 			ChannelID:           event.ChannelID,
 			OldLastPinTimestamp: oldTime,
@@ -1,13 +1,15 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildCreate) {
+func gatewayHandlerGuildCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildCreate) {
 	wasUnready := client.Caches().Guilds().IsUnready(shardID, event.ID)
 	wasUnavailable := client.Caches().Guilds().IsUnavailable(event.ID)
 
@@ -59,7 +61,7 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 	}
 
 	genericGuildEvent := &events.GenericGuild{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		GuildID:      event.ID,
 		Guild:        event.Guild,
 	}
@@ -71,7 +73,7 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 		})
 		if len(client.Caches().Guilds().UnreadyGuilds(shardID)) == 0 {
 			client.EventManager().DispatchEvent(&events.GuildsReady{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			})
 		}
 		if client.MemberChunkingManager().MemberChunkingFilter()(event.ID) {
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -8,7 +10,7 @@ import (
 	"github.com/disgoorg/snowflake/v2"
 )
 
-func gatewayHandlerGuildDelete(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildDelete) {
+func gatewayHandlerGuildDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildDelete) {
 	guild, _ := client.Caches().Guilds().Remove(event.ID)
 	client.Caches().VoiceStates().RemoveAll(event.ID)
 	client.Caches().Presences().RemoveAll(event.ID)
@@ -39,7 +41,7 @@ func gatewayHandlerGuildDelete(client bot.Client, sequenceNumber int, shardID in
 	}
 
 	genericGuildEvent := &events.GenericGuild{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		GuildID:      event.ID,
 		Guild:        guild,
 	}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/cache"
 	"github.com/disgoorg/disgo/discord"
@@ -15,9 +17,9 @@ type updatedEmoji struct {
 	new discord.Emoji
 }
 
-func gatewayHandlerGuildEmojisUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildEmojisUpdate) {
+func gatewayHandlerGuildEmojisUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildEmojisUpdate) {
 	client.EventManager().DispatchEvent(&events.EmojisUpdate{
-		GenericEvent:           events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent:           events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		EventGuildEmojisUpdate: event,
 	})
 
@@ -45,7 +47,7 @@ func gatewayHandlerGuildEmojisUpdate(client bot.Client, sequenceNumber int, shar
 		client.Caches().Emojis().Put(event.GuildID, emoji.ID, emoji)
 		client.EventManager().DispatchEvent(&events.EmojiCreate{
 			GenericEmoji: &events.GenericEmoji{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Emoji:        emoji,
 			},
@@ -56,7 +58,7 @@ func gatewayHandlerGuildEmojisUpdate(client bot.Client, sequenceNumber int, shar
 		client.Caches().Emojis().Put(event.GuildID, emoji.new.ID, emoji.new)
 		client.EventManager().DispatchEvent(&events.EmojiUpdate{
 			GenericEmoji: &events.GenericEmoji{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Emoji:        emoji.new,
 			},
@@ -68,7 +70,7 @@ func gatewayHandlerGuildEmojisUpdate(client bot.Client, sequenceNumber int, shar
 		client.Caches().Emojis().Remove(event.GuildID, emoji.ID)
 		client.EventManager().DispatchEvent(&events.EmojiDelete{
 			GenericEmoji: &events.GenericEmoji{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Emoji:        emoji,
 			},
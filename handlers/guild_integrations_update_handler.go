@@ -1,14 +1,16 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerGuildIntegrationsUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildIntegrationsUpdate) {
+func gatewayHandlerGuildIntegrationsUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildIntegrationsUpdate) {
 	client.EventManager().DispatchEvent(&events.GuildIntegrationsUpdate{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		GuildID:      event.GuildID,
 	})
 }
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/cache"
 	"github.com/disgoorg/disgo/discord"
@@ -14,9 +16,9 @@ type updatedSticker struct {
 	new discord.Sticker
 }
 
-func gatewayHandlerGuildStickersUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildStickersUpdate) {
+func gatewayHandlerGuildStickersUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildStickersUpdate) {
 	client.EventManager().DispatchEvent(&events.StickersUpdate{
-		GenericEvent:             events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent:             events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		EventGuildStickersUpdate: event,
 	})
 
@@ -43,7 +45,7 @@ func gatewayHandlerGuildStickersUpdate(client bot.Client, sequenceNumber int, sh
 	for _, emoji := range createdStickers {
 		client.EventManager().DispatchEvent(&events.StickerCreate{
 			GenericSticker: &events.GenericSticker{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Sticker:      emoji,
 			},
@@ -53,7 +55,7 @@ func gatewayHandlerGuildStickersUpdate(client bot.Client, sequenceNumber int, sh
 	for _, emoji := range updatedStickers {
 		client.EventManager().DispatchEvent(&events.StickerUpdate{
 			GenericSticker: &events.GenericSticker{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Sticker:      emoji.new,
 			},
@@ -64,7 +66,7 @@ func gatewayHandlerGuildStickersUpdate(client bot.Client, sequenceNumber int, sh
 	for _, emoji := range deletedStickers {
 		client.EventManager().DispatchEvent(&events.StickerDelete{
 			GenericSticker: &events.GenericSticker{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
 				Sticker:      emoji,
 			},
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -9,11 +11,11 @@ import (
 	"github.com/disgoorg/disgo/rest"
 )
 
-func gatewayHandlerInteractionCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventInteractionCreate) {
-	handleInteraction(client, sequenceNumber, shardID, nil, event.Interaction)
+func gatewayHandlerInteractionCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventInteractionCreate) {
+	handleInteraction(ctx, client, sequenceNumber, shardID, nil, event.Interaction)
 }
 
-func respond(client bot.Client, respondFunc httpserver.RespondFunc, interaction discord.BaseInteraction) events.InteractionResponderFunc {
+func respond(ctx context.Context, client bot.Client, respondFunc httpserver.RespondFunc, interaction discord.BaseInteraction) events.InteractionResponderFunc {
 	return func(responseType discord.InteractionResponseType, data discord.InteractionResponseData, opts ...rest.RequestOpt) error {
 		response := discord.InteractionResponse{
 			Type: responseType,
@@ -22,18 +24,19 @@ func respond(client bot.Client, respondFunc httpserver.RespondFunc, interaction
 		if respondFunc != nil {
 			return respondFunc(response)
 		}
-		return client.Rest().CreateInteractionResponse(interaction.ID(), interaction.Token(), response, opts...)
+		// prepend the context of the event, so it can be overridden
+		return client.Rest().CreateInteractionResponse(interaction.ID(), interaction.Token(), response, append([]rest.RequestOpt{rest.WithCtx(ctx)}, opts...)...)
 	}
 }
 
-func handleInteraction(client bot.Client, sequenceNumber int, shardID int, respondFunc httpserver.RespondFunc, interaction discord.Interaction) {
+func handleInteraction(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, respondFunc httpserver.RespondFunc, interaction discord.Interaction) {
 
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	client.EventManager().DispatchEvent(&events.InteractionCreate{
 		GenericEvent: genericEvent,
 		Interaction:  interaction,
-		Respond:      respond(client, respondFunc, interaction),
+		Respond:      respond(ctx, client, respondFunc, interaction),
 	})
 
 	switch i := interaction.(type) {
@@ -41,28 +44,28 @@ func handleInteraction(client bot.Client, sequenceNumber int, shardID int, respo
 		client.EventManager().DispatchEvent(&events.ApplicationCommandInteractionCreate{
 			GenericEvent:                  genericEvent,
 			ApplicationCommandInteraction: i,
-			Respond:                       respond(client, respondFunc, interaction),
+			Respond:                       respond(ctx, client, respondFunc, interaction),
 		})
 
 	case discord.ComponentInteraction:
 		client.EventManager().DispatchEvent(&events.ComponentInteractionCreate{
 			GenericEvent:         genericEvent,
 			ComponentInteraction: i,
-			Respond:              respond(client, respondFunc, interaction),
+			Respond:              respond(ctx, client, respondFunc, interaction),
 		})
 
 	case discord.AutocompleteInteraction:
 		client.EventManager().DispatchEvent(&events.AutocompleteInteractionCreate{
 			GenericEvent:            genericEvent,
 			AutocompleteInteraction: i,
-			Respond:                 respond(client, respondFunc, interaction),
+			Respond:                 respond(ctx, client, respondFunc, interaction),
 		})
 
 	case discord.ModalSubmitInteraction:
 		client.EventManager().DispatchEvent(&events.ModalSubmitInteractionCreate{
 			GenericEvent:           genericEvent,
 			ModalSubmitInteraction: i,
-			Respond:                respond(client, respondFunc, interaction),
+			Respond:                respond(ctx, client, respondFunc, interaction),
 		})
 
 	default:
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/httpserver"
@@ -10,7 +12,7 @@ var _ bot.HTTPServerEventHandler = (*httpserverHandlerInteractionCreate)(nil)
 
 type httpserverHandlerInteractionCreate struct{}
 
-func (h *httpserverHandlerInteractionCreate) HandleHTTPEvent(client bot.Client, respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate) {
+func (h *httpserverHandlerInteractionCreate) HandleHTTPEvent(ctx context.Context, client bot.Client, respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate) {
 	// we just want to pong all pings
 	// no need for any event
 	if event.Type() == discord.InteractionTypePing {
@@ -22,5 +24,5 @@ func (h *httpserverHandlerInteractionCreate) HandleHTTPEvent(client bot.Client,
 		}
 		return
 	}
-	handleInteraction(client, -1, -1, respondFunc, event.Interaction)
+	handleInteraction(ctx, client, -1, -1, respondFunc, event.Interaction)
 }
@@ -1,13 +1,15 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/snowflake/v2"
 )
 
-func gatewayHandlerInviteCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventInviteCreate) {
+func gatewayHandlerInviteCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventInviteCreate) {
 	var guildID *snowflake.ID
 	if event.Guild != nil {
 		guildID = &event.Guild.ID
@@ -15,7 +17,7 @@ func gatewayHandlerInviteCreate(client bot.Client, sequenceNumber int, shardID i
 
 	client.EventManager().DispatchEvent(&events.InviteCreate{
 		GenericInvite: &events.GenericInvite{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			GuildID:      guildID,
 			Code:         event.Code,
 			ChannelID:    event.ChannelID,
@@ -24,10 +26,10 @@ func gatewayHandlerInviteCreate(client bot.Client, sequenceNumber int, shardID i
 	})
 }
 
-func gatewayHandlerInviteDelete(client bot.Client, sequenceNumber int, shardID int, event gateway.EventInviteDelete) {
+func gatewayHandlerInviteDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventInviteDelete) {
 	client.EventManager().DispatchEvent(&events.InviteDelete{
 		GenericInvite: &events.GenericInvite{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			GuildID:      event.GuildID,
 			ChannelID:    event.ChannelID,
 			Code:         event.Code,
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -8,7 +10,7 @@ import (
 	"github.com/disgoorg/snowflake/v2"
 )
 
-func gatewayHandlerMessageCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageCreate) {
+func gatewayHandlerMessageCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageCreate) {
 	if event.Flags.Has(discord.MessageFlagEphemeral) {
 		// Ignore ephemeral messages as they miss guild_id & member
 		return
@@ -32,7 +34,7 @@ func gatewayHandlerMessageCreate(client bot.Client, sequenceNumber int, shardID
 		client.Caches().Channels().Put(event.ChannelID, channel)
 	}
 
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 	client.EventManager().DispatchEvent(&events.MessageCreate{
 		GenericMessage: &events.GenericMessage{
 			GenericEvent: genericEvent,
@@ -65,7 +67,7 @@ func gatewayHandlerMessageCreate(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerMessageUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageUpdate) {
+func gatewayHandlerMessageUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageUpdate) {
 	oldMessage, _ := client.Caches().Messages().Get(event.ChannelID, event.ID)
 	client.Caches().Messages().Put(event.ChannelID, event.ID, event.Message)
 
@@ -76,7 +78,7 @@ func gatewayHandlerMessageUpdate(client bot.Client, sequenceNumber int, shardID
 		client.Caches().Channels().Put(event.ChannelID, channel)
 	}
 
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 	client.EventManager().DispatchEvent(&events.MessageUpdate{
 		GenericMessage: &events.GenericMessage{
 			GenericEvent: genericEvent,
@@ -112,18 +114,18 @@ func gatewayHandlerMessageUpdate(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerMessageDelete(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageDelete) {
-	handleMessageDelete(client, sequenceNumber, shardID, event.ID, event.ChannelID, event.GuildID)
+func gatewayHandlerMessageDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageDelete) {
+	handleMessageDelete(ctx, client, sequenceNumber, shardID, event.ID, event.ChannelID, event.GuildID)
 }
 
-func gatewayHandlerMessageDeleteBulk(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageDeleteBulk) {
+func gatewayHandlerMessageDeleteBulk(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageDeleteBulk) {
 	for _, messageID := range event.IDs {
-		handleMessageDelete(client, sequenceNumber, shardID, messageID, event.ChannelID, event.GuildID)
+		handleMessageDelete(ctx, client, sequenceNumber, shardID, messageID, event.ChannelID, event.GuildID)
 	}
 }
 
-func handleMessageDelete(client bot.Client, sequenceNumber int, shardID int, messageID snowflake.ID, channelID snowflake.ID, guildID *snowflake.ID) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func handleMessageDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, messageID snowflake.ID, channelID snowflake.ID, guildID *snowflake.ID) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	message, _ := client.Caches().Messages().Remove(channelID, messageID)
 
@@ -1,13 +1,15 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerMessageReactionAdd(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionAdd) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func gatewayHandlerMessageReactionAdd(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionAdd) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	if event.Member != nil {
 		client.Caches().Members().Put(*event.GuildID, event.UserID, *event.Member)
@@ -50,8 +52,8 @@ func gatewayHandlerMessageReactionAdd(client bot.Client, sequenceNumber int, sha
 	}
 }
 
-func gatewayHandlerMessageReactionRemove(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemove) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func gatewayHandlerMessageReactionRemove(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemove) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	client.EventManager().DispatchEvent(&events.MessageReactionRemove{
 		GenericReaction: &events.GenericReaction{
@@ -88,8 +90,8 @@ func gatewayHandlerMessageReactionRemove(client bot.Client, sequenceNumber int,
 	}
 }
 
-func gatewayHandlerMessageReactionRemoveAll(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemoveAll) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func gatewayHandlerMessageReactionRemoveAll(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemoveAll) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	client.EventManager().DispatchEvent(&events.MessageReactionRemoveAll{
 		GenericEvent: genericEvent,
@@ -114,8 +116,8 @@ func gatewayHandlerMessageReactionRemoveAll(client bot.Client, sequenceNumber in
 	}
 }
 
-func gatewayHandlerMessageReactionRemoveEmoji(client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemoveEmoji) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func gatewayHandlerMessageReactionRemoveEmoji(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventMessageReactionRemoveEmoji) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	client.EventManager().DispatchEvent(&events.MessageReactionRemoveEmoji{
 		GenericEvent: genericEvent,
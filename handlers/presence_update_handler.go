@@ -1,16 +1,18 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerPresenceUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventPresenceUpdate) {
+func gatewayHandlerPresenceUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventPresenceUpdate) {
 	/*oldPresence := client.Caches().Presences().GetCopy(event.GuildID, event.PresenceUser.ID)
 
 	_ = bot.EntityBuilder.CreatePresence(event, core.CacheStrategyYes)
 
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	var (
 		oldStatus       discord.OnlineStatus
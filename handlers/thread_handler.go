@@ -1,19 +1,21 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerThreadCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadCreate) {
+func gatewayHandlerThreadCreate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadCreate) {
 	client.Caches().Channels().Put(event.ID(), event.GuildThread)
 	client.Caches().ThreadMembers().Put(event.ID(), event.ThreadMember.UserID, event.ThreadMember)
 
 	client.EventManager().DispatchEvent(&events.ThreadCreate{
 		GenericThread: &events.GenericThread{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			ThreadID:     event.ID(),
 			GuildID:      event.GuildID(),
 			Thread:       event.GuildThread,
@@ -22,13 +24,13 @@ func gatewayHandlerThreadCreate(client bot.Client, sequenceNumber int, shardID i
 	})
 }
 
-func gatewayHandlerThreadUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadUpdate) {
+func gatewayHandlerThreadUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadUpdate) {
 	oldGuildThread, _ := client.Caches().Channels().GetGuildThread(event.ID())
 	client.Caches().Channels().Put(event.ID(), event.GuildThread)
 
 	client.EventManager().DispatchEvent(&events.ThreadUpdate{
 		GenericThread: &events.GenericThread{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			Thread:       event.GuildThread,
 			ThreadID:     event.ID(),
 			GuildID:      event.GuildID(),
@@ -38,7 +40,7 @@ func gatewayHandlerThreadUpdate(client bot.Client, sequenceNumber int, shardID i
 	})
 }
 
-func gatewayHandlerThreadDelete(client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadDelete) {
+func gatewayHandlerThreadDelete(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadDelete) {
 	var thread discord.GuildThread
 	if channel, ok := client.Caches().Channels().Remove(event.ID); ok {
 		thread, _ = channel.(discord.GuildThread)
@@ -47,7 +49,7 @@ func gatewayHandlerThreadDelete(client bot.Client, sequenceNumber int, shardID i
 
 	client.EventManager().DispatchEvent(&events.ThreadDelete{
 		GenericThread: &events.GenericThread{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			ThreadID:     event.ID,
 			GuildID:      event.GuildID,
 			ParentID:     event.ParentID,
@@ -56,12 +58,12 @@ func gatewayHandlerThreadDelete(client bot.Client, sequenceNumber int, shardID i
 	})
 }
 
-func gatewayHandlerThreadListSync(client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadListSync) {
+func gatewayHandlerThreadListSync(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadListSync) {
 	for _, thread := range event.Threads {
 		client.Caches().Channels().Put(thread.ID(), thread)
 		client.EventManager().DispatchEvent(&events.ThreadShow{
 			GenericThread: &events.GenericThread{
-				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 				Thread:       thread,
 				ThreadID:     thread.ID(),
 				GuildID:      event.GuildID,
@@ -70,12 +72,12 @@ func gatewayHandlerThreadListSync(client bot.Client, sequenceNumber int, shardID
 	}
 }
 
-func gatewayHandlerThreadMemberUpdate(_ bot.Client, _ int, _ int, _ gateway.EventData) {
+func gatewayHandlerThreadMemberUpdate(_ context.Context, _ bot.Client, _ int, _ int, _ gateway.EventData) {
 	// ThreadMembersUpdate kinda handles this already?
 }
 
-func gatewayHandlerThreadMembersUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadMembersUpdate) {
-	genericEvent := events.NewGenericEvent(client, sequenceNumber, shardID)
+func gatewayHandlerThreadMembersUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventThreadMembersUpdate) {
+	genericEvent := events.NewGenericEvent(ctx, client, sequenceNumber, shardID)
 
 	if thread, ok := client.Caches().Channels().GetGuildThread(event.ID); ok {
 		thread.MemberCount = event.MemberCount
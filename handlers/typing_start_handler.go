@@ -1,14 +1,16 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerTypingStart(client bot.Client, sequenceNumber int, shardID int, event gateway.EventTypingStart) {
+func gatewayHandlerTypingStart(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventTypingStart) {
 	client.EventManager().DispatchEvent(&events.UserTypingStart{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		ChannelID:    event.ChannelID,
 		GuildID:      event.GuildID,
 		UserID:       event.UserID,
@@ -17,7 +19,7 @@ func gatewayHandlerTypingStart(client bot.Client, sequenceNumber int, shardID in
 
 	if event.GuildID == nil {
 		client.EventManager().DispatchEvent(&events.DMUserTypingStart{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			ChannelID:    event.ChannelID,
 			UserID:       event.UserID,
 			Timestamp:    event.Timestamp,
@@ -25,7 +27,7 @@ func gatewayHandlerTypingStart(client bot.Client, sequenceNumber int, shardID in
 	} else {
 		client.Caches().Members().Put(*event.GuildID, event.UserID, *event.Member)
 		client.EventManager().DispatchEvent(&events.GuildMemberTypingStart{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 			ChannelID:    event.ChannelID,
 			UserID:       event.UserID,
 			GuildID:      *event.GuildID,
@@ -1,17 +1,19 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerUserUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventUserUpdate) {
+func gatewayHandlerUserUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventUserUpdate) {
 	oldUser, _ := client.Caches().GetSelfUser()
 	client.Caches().PutSelfUser(event.OAuth2User)
 
 	client.EventManager().DispatchEvent(&events.SelfUpdate{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		SelfUser:     event.OAuth2User,
 		OldSelfUser:  oldUser,
 	})
@@ -1,12 +1,14 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 )
 
-func gatewayHandlerVoiceStateUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceStateUpdate) {
+func gatewayHandlerVoiceStateUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceStateUpdate) {
 	member := event.Member
 
 	oldVoiceState, oldOk := client.Caches().VoiceStates().Get(event.GuildID, event.UserID)
@@ -17,8 +19,12 @@ func gatewayHandlerVoiceStateUpdate(client bot.Client, sequenceNumber int, shard
 	}
 	client.Caches().Members().Put(event.GuildID, event.UserID, member)
 
+	if client.VoiceManager() != nil {
+		client.VoiceManager().HandleVoiceStateUpdate(event)
+	}
+
 	genericGuildVoiceEvent := &events.GenericGuildVoiceState{
-		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent: events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		VoiceState:   event.VoiceState,
 		Member:       member,
 	}
@@ -47,9 +53,13 @@ func gatewayHandlerVoiceStateUpdate(client bot.Client, sequenceNumber int, shard
 	}
 }
 
-func gatewayHandlerVoiceServerUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceServerUpdate) {
+func gatewayHandlerVoiceServerUpdate(ctx context.Context, client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceServerUpdate) {
+	if client.VoiceManager() != nil {
+		client.VoiceManager().HandleVoiceServerUpdate(event)
+	}
+
 	client.EventManager().DispatchEvent(&events.VoiceServerUpdate{
-		GenericEvent:           events.NewGenericEvent(client, sequenceNumber, shardID),
+		GenericEvent:           events.NewGenericEvent(ctx, client, sequenceNumber, shardID),
 		EventVoiceServerUpdate: event,
 	})
 }
@@ -0,0 +1,376 @@
+package etf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ToJSON transcodes a single ETF term into JSON.
+func ToJSON(data []byte) ([]byte, error) {
+	return NewDecoder(bytes.NewReader(data)).Decode()
+}
+
+// Decoder reads ETF terms from an io.Reader and transcodes them into JSON.
+// A Decoder may buffer data beyond the current term, so reuse the same Decoder for consecutive terms of a stream.
+type Decoder struct {
+	r       *bufio.Reader
+	scratch [8]byte
+}
+
+// NewDecoder returns a new Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	if br, ok := r.(*bufio.Reader); ok {
+		return &Decoder{r: br}
+	}
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads the next ETF term including its version byte and returns it as JSON.
+func (d *Decoder) Decode() ([]byte, error) {
+	version, err := d.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidVersion, version)
+	}
+
+	buf := make([]byte, 0, 512)
+	return d.decodeTerm(buf)
+}
+
+func (d *Decoder) readN(n int) ([]byte, error) {
+	if n <= len(d.scratch) {
+		_, err := io.ReadFull(d.r, d.scratch[:n])
+		return d.scratch[:n], err
+	}
+	b := make([]byte, n)
+	_, err := io.ReadFull(d.r, b)
+	return b, err
+}
+
+func (d *Decoder) readUint8() (uint8, error) {
+	return d.r.ReadByte()
+}
+
+func (d *Decoder) readUint16() (uint16, error) {
+	b, err := d.readN(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func (d *Decoder) readUint32() (uint32, error) {
+	b, err := d.readN(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
+
+func (d *Decoder) decodeTerm(buf []byte) ([]byte, error) {
+	tag, err := d.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagSmallInteger:
+		var v uint8
+		if v, err = d.readUint8(); err != nil {
+			return nil, err
+		}
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+
+	case tagInteger:
+		var v uint32
+		if v, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(buf, int64(int32(v)), 10), nil
+
+	case tagNewFloat:
+		var b []byte
+		if b, err = d.readN(8); err != nil {
+			return nil, err
+		}
+		return appendFloat(buf, math.Float64frombits(binary.BigEndian.Uint64(b))), nil
+
+	case tagFloat:
+		var b []byte
+		if b, err = d.readN(31); err != nil {
+			return nil, err
+		}
+		var f float64
+		if f, err = strconv.ParseFloat(string(bytes.TrimRight(b, "\x00")), 64); err != nil {
+			return nil, fmt.Errorf("etf: invalid float: %w", err)
+		}
+		return appendFloat(buf, f), nil
+
+	case tagAtom, tagAtomUTF8:
+		var n uint16
+		if n, err = d.readUint16(); err != nil {
+			return nil, err
+		}
+		return d.decodeAtom(buf, int(n))
+
+	case tagSmallAtom, tagSmallAtomUTF8:
+		var n uint8
+		if n, err = d.readUint8(); err != nil {
+			return nil, err
+		}
+		return d.decodeAtom(buf, int(n))
+
+	case tagBinary:
+		var n uint32
+		if n, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		var b []byte
+		if b, err = d.readN(int(n)); err != nil {
+			return nil, err
+		}
+		return appendString(buf, b), nil
+
+	case tagString:
+		var n uint16
+		if n, err = d.readUint16(); err != nil {
+			return nil, err
+		}
+		var b []byte
+		if b, err = d.readN(int(n)); err != nil {
+			return nil, err
+		}
+		return appendString(buf, b), nil
+
+	case tagNil:
+		return append(buf, '[', ']'), nil
+
+	case tagList:
+		var n uint32
+		if n, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		if buf, err = d.decodeArray(buf, int(n)); err != nil {
+			return nil, err
+		}
+		// proper lists end with a nil tail, improper tails can't be represented in JSON
+		var tail byte
+		if tail, err = d.r.ReadByte(); err != nil {
+			return nil, err
+		}
+		if tail != tagNil {
+			return nil, fmt.Errorf("%w: improper list tail %d", ErrUnsupportedTag, tail)
+		}
+		return buf, nil
+
+	case tagSmallTuple:
+		var n uint8
+		if n, err = d.readUint8(); err != nil {
+			return nil, err
+		}
+		return d.decodeArray(buf, int(n))
+
+	case tagLargeTuple:
+		var n uint32
+		if n, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		return d.decodeArray(buf, int(n))
+
+	case tagMap:
+		var n uint32
+		if n, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		return d.decodeMap(buf, int(n))
+
+	case tagSmallBig:
+		var n uint8
+		if n, err = d.readUint8(); err != nil {
+			return nil, err
+		}
+		return d.decodeBig(buf, int(n))
+
+	case tagLargeBig:
+		var n uint32
+		if n, err = d.readUint32(); err != nil {
+			return nil, err
+		}
+		return d.decodeBig(buf, int(n))
+
+	case tagCompressed:
+		return d.decodeCompressed(buf)
+
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTag, tag)
+	}
+}
+
+func (d *Decoder) decodeAtom(buf []byte, n int) ([]byte, error) {
+	b, err := d.readN(n)
+	if err != nil {
+		return nil, err
+	}
+	switch string(b) {
+	case "nil", "null":
+		return append(buf, "null"...), nil
+	case "true":
+		return append(buf, "true"...), nil
+	case "false":
+		return append(buf, "false"...), nil
+	default:
+		return appendString(buf, b), nil
+	}
+}
+
+func (d *Decoder) decodeArray(buf []byte, n int) ([]byte, error) {
+	var err error
+	buf = append(buf, '[')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = d.decodeTerm(buf); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, ']'), nil
+}
+
+func (d *Decoder) decodeMap(buf []byte, n int) ([]byte, error) {
+	var err error
+	buf = append(buf, '{')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		keyStart := len(buf)
+		if buf, err = d.decodeTerm(buf); err != nil {
+			return nil, err
+		}
+		// json only allows string keys
+		if buf[keyStart] != '"' {
+			key := string(buf[keyStart:])
+			buf = appendString(buf[:keyStart], []byte(key))
+		}
+		buf = append(buf, ':')
+		if buf, err = d.decodeTerm(buf); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, '}'), nil
+}
+
+func (d *Decoder) decodeBig(buf []byte, n int) ([]byte, error) {
+	sign, err := d.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	digits, err := d.readN(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var v uint64
+	for i := len(digits) - 1; i >= 0; i-- {
+		if i >= 8 && digits[i] != 0 {
+			return nil, ErrIntegerOverflow
+		}
+		v = v<<8 | uint64(digits[i])
+	}
+
+	if v < snowflakeThreshold {
+		if sign != 0 {
+			buf = append(buf, '-')
+		}
+		return strconv.AppendUint(buf, v, 10), nil
+	}
+
+	buf = append(buf, '"')
+	if sign != 0 {
+		buf = append(buf, '-')
+	}
+	buf = strconv.AppendUint(buf, v, 10)
+	return append(buf, '"'), nil
+}
+
+func (d *Decoder) decodeCompressed(buf []byte) ([]byte, error) {
+	size, err := d.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	zlibReader, err := zlib.NewReader(d.r)
+	if err != nil {
+		return nil, fmt.Errorf("etf: invalid compressed term: %w", err)
+	}
+	defer zlibReader.Close()
+
+	inner := NewDecoder(io.LimitReader(zlibReader, int64(size)))
+	if buf, err = inner.decodeTerm(buf); err != nil {
+		return nil, err
+	}
+	// consume the adler-32 checksum so the underlying reader is positioned after the compressed term
+	if _, err = io.Copy(io.Discard, zlibReader); err != nil {
+		return nil, fmt.Errorf("etf: invalid compressed term: %w", err)
+	}
+	return buf, nil
+}
+
+func appendFloat(buf []byte, f float64) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(buf, "null"...)
+	}
+	return strconv.AppendFloat(buf, f, 'g', -1, 64)
+}
+
+const hex = "0123456789abcdef"
+
+// appendString appends the given bytes as a quoted & escaped JSON string.
+func appendString(buf []byte, s []byte) []byte {
+	buf = append(buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+			buf = append(buf, s[start:i]...)
+			switch b {
+			case '"', '\\':
+				buf = append(buf, '\\', b)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	buf = append(buf, s[start:]...)
+	return append(buf, '"')
+}
@@ -0,0 +1,145 @@
+package etf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FromJSON transcodes the given JSON into a single ETF term including its version byte.
+func FromJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1, len(data))
+	buf[0] = Version
+	return appendTerm(buf, v)
+}
+
+func appendTerm(buf []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return appendAtom(buf, "nil"), nil
+
+	case bool:
+		if v {
+			return appendAtom(buf, "true"), nil
+		}
+		return appendAtom(buf, "false"), nil
+
+	case string:
+		buf = append(buf, tagBinary)
+		buf = appendUint32(buf, uint32(len(v)))
+		return append(buf, v...), nil
+
+	case json.Number:
+		return appendNumber(buf, v)
+
+	case []any:
+		if len(v) == 0 {
+			return append(buf, tagNil), nil
+		}
+		buf = append(buf, tagList)
+		buf = appendUint32(buf, uint32(len(v)))
+		var err error
+		for _, e := range v {
+			if buf, err = appendTerm(buf, e); err != nil {
+				return nil, err
+			}
+		}
+		return append(buf, tagNil), nil
+
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buf = append(buf, tagMap)
+		buf = appendUint32(buf, uint32(len(v)))
+		var err error
+		for _, key := range keys {
+			if buf, err = appendTerm(buf, key); err != nil {
+				return nil, err
+			}
+			if buf, err = appendTerm(buf, v[key]); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+
+	default:
+		return nil, fmt.Errorf("etf: unsupported json value of type %T", v)
+	}
+}
+
+func appendAtom(buf []byte, atom string) []byte {
+	buf = append(buf, tagSmallAtomUTF8, byte(len(atom)))
+	return append(buf, atom...)
+}
+
+func appendNumber(buf []byte, n json.Number) ([]byte, error) {
+	s := n.String()
+	if strings.ContainsAny(s, ".eE") {
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, tagNewFloat)
+		return appendUint64(buf, math.Float64bits(f)), nil
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	u, err := strconv.ParseUint(strings.TrimPrefix(s, "-"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("etf: invalid integer: %w", err)
+	}
+
+	switch {
+	case !negative && u <= math.MaxUint8:
+		return append(buf, tagSmallInteger, byte(u)), nil
+
+	case !negative && u <= math.MaxInt32, negative && u <= -math.MinInt32:
+		i := int32(u)
+		if negative {
+			i = int32(-int64(u))
+		}
+		buf = append(buf, tagInteger)
+		return appendUint32(buf, uint32(i)), nil
+	}
+
+	var sign byte
+	if negative {
+		sign = 1
+	}
+	digits := make([]byte, 0, 8)
+	for ; u > 0; u >>= 8 {
+		digits = append(digits, byte(u))
+	}
+	buf = append(buf, tagSmallBig, byte(len(digits)), sign)
+	return append(buf, digits...), nil
+}
+
+// appendUint32 & appendUint64 can be replaced with binary.BigEndian.AppendUint32/64 once go 1.19 is the minimum version.
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
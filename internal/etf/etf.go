@@ -0,0 +1,61 @@
+// Package etf transcodes between Erlang's External Term Format and JSON.
+//
+// Discord's gateway can send & receive ETF instead of JSON. Instead of teaching every disgo type a second encoding,
+// ETF payloads are transcoded to JSON and back, so the existing (Un)MarshalJSON implementations stay the single source of truth.
+//
+// The following mapping is used:
+//
+//	ETF                             JSON
+//	atom nil/null                   null
+//	atom true/false                 true/false
+//	other atoms, binaries, strings  string
+//	small integer, integer          number
+//	small/large big                 number, or string if |n| >= 2^44 (snowflakes)
+//	float, new float                number
+//	nil, list, tuple                array
+//	map                             object
+//
+// Big integers above 2^44 are emitted as strings as Discord sends snowflakes as 64-bit integers over ETF,
+// while disgo expects them as JSON strings. Millisecond unix timestamps stay below that threshold until the year 2527.
+package etf
+
+import "errors"
+
+// Version is the magic version byte every ETF term starts with.
+const Version = 131
+
+const (
+	tagNewFloat      = 70
+	tagBitBinary     = 77
+	tagCompressed    = 80
+	tagSmallInteger  = 97
+	tagInteger       = 98
+	tagFloat         = 99
+	tagAtom          = 100
+	tagSmallTuple    = 104
+	tagLargeTuple    = 105
+	tagNil           = 106
+	tagString        = 107
+	tagList          = 108
+	tagBinary        = 109
+	tagSmallBig      = 110
+	tagLargeBig      = 111
+	tagSmallAtom     = 115
+	tagMap           = 116
+	tagAtomUTF8      = 118
+	tagSmallAtomUTF8 = 119
+)
+
+// snowflakeThreshold is the value from which on big integers are treated as snowflakes and emitted as JSON strings.
+const snowflakeThreshold = 1 << 44
+
+var (
+	// ErrInvalidVersion is returned when the term does not start with the Version byte.
+	ErrInvalidVersion = errors.New("etf: invalid version byte")
+
+	// ErrUnsupportedTag is returned when the term contains a tag which can't be represented in JSON.
+	ErrUnsupportedTag = errors.New("etf: unsupported tag")
+
+	// ErrIntegerOverflow is returned when a big integer does not fit into 64 bits.
+	ErrIntegerOverflow = errors.New("etf: big integer overflows 64 bits")
+)
@@ -0,0 +1,285 @@
+package metrics
+
+import (
+	"bufio"
+	"expvar"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/json"
+)
+
+// ContentType is the content type of the OpenMetrics text format written by the Collector.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// DefaultBuckets are the histogram buckets in seconds used by the Collector.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var (
+	_ Collector  = (*collectorImpl)(nil)
+	_ expvar.Var = (*collectorImpl)(nil)
+)
+
+// NewCollector returns a new Collector.
+func NewCollector() Collector {
+	c := &collectorImpl{}
+	c.restRequests = c.newFamily("disgo_rest_request_duration_seconds", "Duration of REST requests.", typeHistogram, "method", "route", "status")
+	c.restRateLimited = c.newFamily("disgo_rest_rate_limited", "REST requests which received a 429 response.", typeCounter, "method", "route", "scope")
+	c.rateLimitWaits = c.newFamily("disgo_rest_rate_limit_wait_seconds", "Time REST requests waited for their rate limit bucket.", typeHistogram, "bucket")
+	c.gatewayLatency = c.newFamily("disgo_gateway_heartbeat_latency_seconds", "Latency of the last gateway heartbeat.", typeGauge, "shard")
+	c.gatewayReconnects = c.newFamily("disgo_gateway_reconnects", "Gateway reconnects.", typeCounter, "shard")
+	c.gatewayEvents = c.newFamily("disgo_gateway_events", "Gateway dispatch events received.", typeCounter, "shard", "type")
+	c.eventListeners = c.newFamily("disgo_event_listener_duration_seconds", "Duration of event listeners handling an event.", typeHistogram, "type")
+	return c
+}
+
+// Collector is a Metrics implementation which keeps all metrics in memory.
+// It serves them in the OpenMetrics text format as http.Handler & implements expvar.Var, so it can be published with expvar.Publish.
+type Collector interface {
+	Metrics
+	http.Handler
+	io.WriterTo
+
+	// String returns all metrics as JSON for expvar.
+	String() string
+}
+
+type metricType string
+
+const (
+	typeCounter   metricType = "counter"
+	typeGauge     metricType = "gauge"
+	typeHistogram metricType = "histogram"
+)
+
+type collectorImpl struct {
+	mu       sync.Mutex
+	families []*family
+
+	restRequests      *family
+	restRateLimited   *family
+	rateLimitWaits    *family
+	gatewayLatency    *family
+	gatewayReconnects *family
+	gatewayEvents     *family
+	eventListeners    *family
+}
+
+type family struct {
+	name   string
+	help   string
+	typ    metricType
+	labels []string
+	series map[string]*series
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+	buckets     []uint64
+	count       uint64
+}
+
+func (c *collectorImpl) newFamily(name string, help string, typ metricType, labels ...string) *family {
+	f := &family{
+		name:   name,
+		help:   help,
+		typ:    typ,
+		labels: labels,
+		series: map[string]*series{},
+	}
+	c.families = append(c.families, f)
+	return f
+}
+
+func (c *collectorImpl) get(f *family, labelValues ...string) *series {
+	key := strings.Join(labelValues, "\xff")
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{labelValues: labelValues}
+		if f.typ == typeHistogram {
+			s.buckets = make([]uint64, len(DefaultBuckets))
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+func (c *collectorImpl) add(f *family, value float64, labelValues ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.get(f, labelValues...).value += value
+}
+
+func (c *collectorImpl) set(f *family, value float64, labelValues ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.get(f, labelValues...).value = value
+}
+
+func (c *collectorImpl) observe(f *family, value float64, labelValues ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.get(f, labelValues...)
+	s.value += value
+	s.count++
+	for i, bound := range DefaultBuckets {
+		if value <= bound {
+			s.buckets[i]++
+		}
+	}
+}
+
+func (c *collectorImpl) RESTRequest(method string, route string, status int, duration time.Duration) {
+	c.observe(c.restRequests, duration.Seconds(), method, route, strconv.Itoa(status))
+}
+
+func (c *collectorImpl) RESTRateLimited(method string, route string, scope string) {
+	c.add(c.restRateLimited, 1, method, route, scope)
+}
+
+func (c *collectorImpl) RateLimitWait(bucket string, wait time.Duration) {
+	c.observe(c.rateLimitWaits, wait.Seconds(), bucket)
+}
+
+func (c *collectorImpl) GatewayHeartbeatLatency(shardID int, latency time.Duration) {
+	c.set(c.gatewayLatency, latency.Seconds(), strconv.Itoa(shardID))
+}
+
+func (c *collectorImpl) GatewayReconnect(shardID int) {
+	c.add(c.gatewayReconnects, 1, strconv.Itoa(shardID))
+}
+
+func (c *collectorImpl) GatewayEvent(shardID int, eventType string) {
+	c.add(c.gatewayEvents, 1, strconv.Itoa(shardID), eventType)
+}
+
+func (c *collectorImpl) EventListener(eventType string, duration time.Duration) {
+	c.observe(c.eventListeners, duration.Seconds(), eventType)
+}
+
+func (c *collectorImpl) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = c.WriteTo(w)
+}
+
+// WriteTo writes all metrics in the OpenMetrics text format to the given io.Writer.
+func (c *collectorImpl) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	for _, f := range c.families {
+		cw.write("# TYPE ", f.name, " ", string(f.typ), "\n")
+		cw.write("# HELP ", f.name, " ", f.help, "\n")
+		for _, s := range f.sortedSeries() {
+			labels := formatLabels(f.labels, s.labelValues)
+			switch f.typ {
+			case typeCounter:
+				cw.write(f.name, "_total", wrapLabels(labels), " ", formatFloat(s.value), "\n")
+			case typeGauge:
+				cw.write(f.name, wrapLabels(labels), " ", formatFloat(s.value), "\n")
+			case typeHistogram:
+				if labels != "" {
+					labels += ","
+				}
+				for i, bound := range DefaultBuckets {
+					cw.write(f.name, "_bucket{", labels, `le="`, formatFloat(bound), `"} `, strconv.FormatUint(s.buckets[i], 10), "\n")
+				}
+				cw.write(f.name, "_bucket{", labels, `le="+Inf"} `, strconv.FormatUint(s.count, 10), "\n")
+				labels = strings.TrimSuffix(labels, ",")
+				cw.write(f.name, "_sum", wrapLabels(labels), " ", formatFloat(s.value), "\n")
+				cw.write(f.name, "_count", wrapLabels(labels), " ", strconv.FormatUint(s.count, 10), "\n")
+			}
+		}
+	}
+	cw.write("# EOF\n")
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// String returns all metrics as JSON object of metric name to label values to value. Histograms are represented by their sum & count.
+func (c *collectorImpl) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	values := make(map[string]map[string]any, len(c.families))
+	for _, f := range c.families {
+		familyValues := make(map[string]any, len(f.series))
+		for _, s := range f.series {
+			key := formatLabels(f.labels, s.labelValues)
+			if f.typ == typeHistogram {
+				familyValues[key] = map[string]any{"sum": s.value, "count": s.count}
+				continue
+			}
+			familyValues[key] = s.value
+		}
+		values[f.name] = familyValues
+	}
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
+func (f *family) sortedSeries() []*series {
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := make([]*series, len(keys))
+	for i, key := range keys {
+		sorted[i] = f.series[key]
+	}
+	return sorted
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabels(names []string, values []string) string {
+	var sb strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(name)
+		sb.WriteString(`="`)
+		sb.WriteString(labelValueReplacer.Replace(values[i]))
+		sb.WriteByte('"')
+	}
+	return sb.String()
+}
+
+func wrapLabels(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) write(parts ...string) {
+	for _, part := range parts {
+		if w.err != nil {
+			return
+		}
+		var n int
+		n, w.err = w.w.WriteString(part)
+		w.n += int64(n)
+	}
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	c.RESTRequest(http.MethodGet, "/channels/{channel.id}", 200, 20*time.Millisecond)
+	c.RESTRequest(http.MethodGet, "/channels/{channel.id}", 200, 2*time.Second)
+	c.RESTRateLimited(http.MethodPost, "/channels/{channel.id}/messages", "user")
+	c.RateLimitWait("POST+/channels/{channel.id}/messages", 500*time.Millisecond)
+	c.GatewayHeartbeatLatency(1, 40*time.Millisecond)
+	c.GatewayReconnect(1)
+	c.GatewayEvent(1, "MESSAGE_CREATE")
+	c.GatewayEvent(1, "MESSAGE_CREATE")
+	c.EventListener("*events.MessageCreate", time.Millisecond)
+
+	rs := httptest.NewRecorder()
+	c.ServeHTTP(rs, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, ContentType, rs.Header().Get("Content-Type"))
+
+	body := rs.Body.String()
+	for _, line := range []string{
+		"# TYPE disgo_rest_request_duration_seconds histogram",
+		`disgo_rest_request_duration_seconds_bucket{method="GET",route="/channels/{channel.id}",status="200",le="0.025"} 1`,
+		`disgo_rest_request_duration_seconds_bucket{method="GET",route="/channels/{channel.id}",status="200",le="+Inf"} 2`,
+		`disgo_rest_request_duration_seconds_sum{method="GET",route="/channels/{channel.id}",status="200"} 2.02`,
+		`disgo_rest_request_duration_seconds_count{method="GET",route="/channels/{channel.id}",status="200"} 2`,
+		`disgo_rest_rate_limited_total{method="POST",route="/channels/{channel.id}/messages",scope="user"} 1`,
+		`disgo_rest_rate_limit_wait_seconds_count{bucket="POST+/channels/{channel.id}/messages"} 1`,
+		`disgo_gateway_heartbeat_latency_seconds{shard="1"} 0.04`,
+		`disgo_gateway_reconnects_total{shard="1"} 1`,
+		`disgo_gateway_events_total{shard="1",type="MESSAGE_CREATE"} 2`,
+		`disgo_event_listener_duration_seconds_count{type="*events.MessageCreate"} 1`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+	assert.True(t, strings.HasSuffix(body, "# EOF\n"))
+
+	expvar.Publish("disgo_test", c)
+	var values map[string]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("disgo_test").String()), &values))
+	assert.Equal(t, float64(2), values["disgo_gateway_events"][`shard="1",type="MESSAGE_CREATE"`])
+}
@@ -0,0 +1,50 @@
+// Package metrics provides an interface to observe the internals of disgo like REST latency, rate limits, gateway latency & event handling.
+// By default, Noop is used which records nothing. NewCollector returns a Metrics implementation which exposes all metrics in the OpenMetrics text format & via expvar.
+//
+//	collector := metrics.NewCollector()
+//	client, err := disgo.New(token, bot.WithMetrics(collector))
+//	http.Handle("/metrics", collector)
+//	expvar.Publish("disgo", collector)
+package metrics
+
+import (
+	"time"
+)
+
+// Metrics records metrics of the rest.Client, rest.RateLimiter, gateway.Gateway & bot.EventManager.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// RESTRequest records a request to the given route. status is 0 if the request failed without a response.
+	RESTRequest(method string, route string, status int, duration time.Duration)
+
+	// RESTRateLimited records a 429 response for the given route with the value of the X-RateLimit-Scope header.
+	RESTRateLimited(method string, route string, scope string)
+
+	// RateLimitWait records how long a request waited for the rate limit of the given bucket. bucket is the Discord bucket hash or the method & route while it is not known yet.
+	RateLimitWait(bucket string, wait time.Duration)
+
+	// GatewayHeartbeatLatency records the heartbeat latency of the given shard.
+	GatewayHeartbeatLatency(shardID int, latency time.Duration)
+
+	// GatewayReconnect records a reconnect of the given shard.
+	GatewayReconnect(shardID int)
+
+	// GatewayEvent records a dispatch event of the given type received by the given shard.
+	GatewayEvent(shardID int, eventType string)
+
+	// EventListener records how long an event listener took to handle an event of the given type.
+	EventListener(eventType string, duration time.Duration)
+}
+
+// Noop is a Metrics implementation which records nothing. It is used by default.
+var Noop Metrics = noopMetrics{}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RESTRequest(string, string, int, time.Duration) {}
+func (noopMetrics) RESTRateLimited(string, string, string)         {}
+func (noopMetrics) RateLimitWait(string, time.Duration)            {}
+func (noopMetrics) GatewayHeartbeatLatency(int, time.Duration)     {}
+func (noopMetrics) GatewayReconnect(int)                           {}
+func (noopMetrics) GatewayEvent(int, string)                       {}
+func (noopMetrics) EventListener(string, time.Duration)            {}
@@ -29,6 +29,7 @@ type Channels interface {
 	GetMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	GetMessages(channelID snowflake.ID, around snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Message, error)
 	GetMessagesPage(channelID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Message]
+	GetMessagesIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Message]
 	CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (*discord.Message, error)
 	UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (*discord.Message, error)
 	DeleteMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
@@ -36,6 +37,7 @@ type Channels interface {
 	CrosspostMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 
 	GetReactions(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) ([]discord.User, error)
+	GetReactionsIterator(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) *Iterator[discord.User]
 	AddReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error
 	RemoveOwnReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error
 	RemoveUserReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, userID snowflake.ID, opts ...RequestOpt) error
@@ -141,6 +143,19 @@ func (s *channelImpl) GetMessagesPage(channelID snowflake.ID, startID snowflake.
 	}
 }
 
+func (s *channelImpl) GetMessagesIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Message] {
+	return newIterator(100, opts, func() pager[discord.Message] {
+		var before snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.Message, bool, error) {
+			messages, err := s.GetMessages(channelID, 0, before, 0, limit, opts...)
+			if len(messages) > 0 {
+				before = messages[len(messages)-1].ID
+			}
+			return messages, len(messages) == limit, err
+		}
+	})
+}
+
 func (s *channelImpl) CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (message *discord.Message, err error) {
 	body, err := messageCreate.ToBody()
 	if err != nil {
@@ -177,6 +192,23 @@ func (s *channelImpl) GetReactions(channelID snowflake.ID, messageID snowflake.I
 	return
 }
 
+func (s *channelImpl) GetReactionsIterator(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) *Iterator[discord.User] {
+	return newIterator(100, opts, func() pager[discord.User] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.User, bool, error) {
+			opts = append(opts, WithQueryParam("limit", limit))
+			if after != 0 {
+				opts = append(opts, WithQueryParam("after", after))
+			}
+			users, err := s.GetReactions(channelID, messageID, emoji, opts...)
+			if len(users) > 0 {
+				after = users[len(users)-1].ID
+			}
+			return users, len(users) == limit, err
+		}
+	})
+}
+
 func (s *channelImpl) AddReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error {
 	return s.client.Do(AddReaction.Compile(nil, channelID, messageID, emoji), nil, nil, opts...)
 }
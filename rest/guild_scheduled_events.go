@@ -20,6 +20,7 @@ type GuildScheduledEvents interface {
 
 	GetGuildScheduledEventUsers(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.GuildScheduledEventUser, error)
 	GetGuildScheduledEventUsersPage(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.GuildScheduledEventUser]
+	GetGuildScheduledEventUsersIterator(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, opts ...RequestOpt) *Iterator[discord.GuildScheduledEventUser]
 }
 
 type guildScheduledEventImpl struct {
@@ -87,3 +88,16 @@ func (s *guildScheduledEventImpl) GetGuildScheduledEventUsersPage(guildID snowfl
 		ID: startID,
 	}
 }
+
+func (s *guildScheduledEventImpl) GetGuildScheduledEventUsersIterator(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, opts ...RequestOpt) *Iterator[discord.GuildScheduledEventUser] {
+	return newIterator(100, opts, func() pager[discord.GuildScheduledEventUser] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.GuildScheduledEventUser, bool, error) {
+			users, err := s.GetGuildScheduledEventUsers(guildID, guildScheduledEventID, withMember, 0, after, limit, opts...)
+			if len(users) > 0 {
+				after = users[len(users)-1].User.ID
+			}
+			return users, len(users) == limit, err
+		}
+	})
+}
@@ -35,6 +35,7 @@ type Guilds interface {
 
 	GetBans(guildID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Ban, error)
 	GetBansPage(guildID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Ban]
+	GetBansIterator(guildID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Ban]
 	GetBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (*discord.Ban, error)
 	AddBan(guildID snowflake.ID, userID snowflake.ID, deleteMessageDuration time.Duration, opts ...RequestOpt) error
 	DeleteBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
@@ -51,6 +52,7 @@ type Guilds interface {
 
 	GetAuditLog(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, limit int, opts ...RequestOpt) (*discord.AuditLog, error)
 	GetAuditLogPage(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, startID snowflake.ID, limit int, opts ...RequestOpt) AuditLogPage
+	GetAuditLogIterator(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, opts ...RequestOpt) *Iterator[discord.AuditLogEntry]
 
 	GetGuildWelcomeScreen(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildWelcomeScreen, error)
 	UpdateGuildWelcomeScreen(guildID snowflake.ID, screenUpdate discord.GuildWelcomeScreenUpdate, opts ...RequestOpt) (*discord.GuildWelcomeScreen, error)
@@ -173,6 +175,19 @@ func (s *guildImpl) GetBansPage(guildID snowflake.ID, startID snowflake.ID, limi
 	}
 }
 
+func (s *guildImpl) GetBansIterator(guildID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Ban] {
+	return newIterator(1000, opts, func() pager[discord.Ban] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.Ban, bool, error) {
+			bans, err := s.GetBans(guildID, 0, after, limit, opts...)
+			if len(bans) > 0 {
+				after = bans[len(bans)-1].User.ID
+			}
+			return bans, len(bans) == limit, err
+		}
+	})
+}
+
 func (s *guildImpl) GetBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (ban *discord.Ban, err error) {
 	err = s.client.Do(GetBan.Compile(nil, guildID, userID), nil, &ban, opts...)
 	return
@@ -258,6 +273,23 @@ func (s *guildImpl) GetAuditLogPage(guildID snowflake.ID, userID snowflake.ID, a
 	}
 }
 
+func (s *guildImpl) GetAuditLogIterator(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, opts ...RequestOpt) *Iterator[discord.AuditLogEntry] {
+	return newIterator(100, opts, func() pager[discord.AuditLogEntry] {
+		var before snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.AuditLogEntry, bool, error) {
+			auditLog, err := s.GetAuditLog(guildID, userID, actionType, before, limit, opts...)
+			if err != nil || auditLog == nil {
+				return nil, false, err
+			}
+			entries := auditLog.AuditLogEntries
+			if len(entries) > 0 {
+				before = entries[len(entries)-1].ID
+			}
+			return entries, len(entries) == limit, nil
+		}
+	})
+}
+
 func (s *guildImpl) GetGuildWelcomeScreen(guildID snowflake.ID, opts ...RequestOpt) (welcomeScreen *discord.GuildWelcomeScreen, err error) {
 	err = s.client.Do(GetGuildWelcomeScreen.Compile(nil, guildID), nil, &welcomeScreen, opts...)
 	return
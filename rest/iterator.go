@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrStopIteration can be returned by the callback of Iterator.Each to stop the iteration without an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// pager fetches the page after the previous one with at most limit items & reports whether there might be more pages.
+type pager[T any] func(limit int, opts []RequestOpt) (items []T, more bool, err error)
+
+func newIterator[T any](pageSize int, opts []RequestOpt, newPager func() pager[T]) *Iterator[T] {
+	return &Iterator[T]{
+		pageSize: pageSize,
+		prefetch: 1,
+		opts:     opts,
+		newPager: newPager,
+	}
+}
+
+// Iterator streams all items of a paginated endpoint & fetches the pages as needed.
+// While the items of a page are handled, the next page is already fetched in the background.
+// Pages after the first one are fetched with PriorityLow, so prefetching does not hold up other requests waiting for the same rate limit bucket.
+// The Iterator can be iterated multiple times, each iteration starts at the first page.
+type Iterator[T any] struct {
+	pageSize int
+	limit    int
+	prefetch int
+	opts     []RequestOpt
+	newPager func() pager[T]
+}
+
+// Limit sets the maximum number of items the Iterator returns. 0 means no limit.
+func (i *Iterator[T]) Limit(limit int) *Iterator[T] {
+	i.limit = limit
+	return i
+}
+
+// Prefetch sets how many pages are fetched ahead of the page currently handled. It defaults to 1.
+// 0 disables prefetching, so each page is only fetched once all items of the previous one were handled.
+func (i *Iterator[T]) Prefetch(pages int) *Iterator[T] {
+	i.prefetch = pages
+	return i
+}
+
+// Each calls fn for every item until all items were handled, fn returns an error or the context.Context is done.
+// The context.Context is used for all requests. Return ErrStopIteration from fn to stop without an error.
+func (i *Iterator[T]) Each(ctx context.Context, fn func(item T) error) error {
+	pagesCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages, handled := i.pages(pagesCtx)
+	for page := range pages {
+		if page.err != nil {
+			return page.err
+		}
+		for _, item := range page.items {
+			if err := fn(item); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		handled()
+	}
+	return ctx.Err()
+}
+
+// Chan sends all items to the returned channel. The channel is closed after all items were sent, an error occurred or the context.Context is done.
+// The returned func returns the error of the iteration once the channel is closed.
+func (i *Iterator[T]) Chan(ctx context.Context) (<-chan T, func() error) {
+	items := make(chan T)
+	var err error
+	go func() {
+		defer close(items)
+		err = i.Each(ctx, func(item T) error {
+			select {
+			case items <- item:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return items, func() error {
+		return err
+	}
+}
+
+// All returns all items.
+func (i *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	err := i.Each(ctx, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	return all, err
+}
+
+type iteratorPage[T any] struct {
+	items []T
+	err   error
+}
+
+// pages fetches the pages in a new goroutine until there are no more pages, the limit is reached or the context.Context is done.
+// A page is only fetched if it is at most prefetch pages ahead of the page currently handled. Call the returned func once a page was handled.
+func (i *Iterator[T]) pages(ctx context.Context) (<-chan iteratorPage[T], func()) {
+	prefetch := i.prefetch
+	if prefetch < 0 {
+		prefetch = 0
+	}
+	// demand holds one token for the page currently handled & one for each page which may be fetched ahead of it
+	demand := make(chan struct{}, prefetch+1)
+	for j := 0; j <= prefetch; j++ {
+		demand <- struct{}{}
+	}
+	pages := make(chan iteratorPage[T], prefetch+1)
+	go func() {
+		defer close(pages)
+
+		send := func(page iteratorPage[T]) bool {
+			select {
+			case pages <- page:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		next := i.newPager()
+		var fetched int
+		for {
+			select {
+			case <-demand:
+			case <-ctx.Done():
+				return
+			}
+
+			limit := i.pageSize
+			if i.limit > 0 && i.limit-fetched < limit {
+				limit = i.limit - fetched
+			}
+
+			opts := make([]RequestOpt, 0, len(i.opts)+2)
+			if fetched > 0 {
+				opts = append(opts, WithPriority(PriorityLow))
+			}
+			opts = append(opts, i.opts...)
+			items, more, err := next(limit, append(opts, WithCtx(ctx)))
+			if err != nil {
+				if ctx.Err() == nil {
+					send(iteratorPage[T]{err: err})
+				}
+				return
+			}
+			if len(items) > limit {
+				items = items[:limit]
+			}
+			fetched += len(items)
+
+			if !send(iteratorPage[T]{items: items}) || !more || len(items) == 0 || (i.limit > 0 && fetched >= i.limit) {
+				return
+			}
+		}
+	}()
+	return pages, func() {
+		demand <- struct{}{}
+	}
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		after, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		// 2500 members with the IDs 1 to 2500
+		var members []string
+		for id := after + 1; id <= 2500 && len(members) < limit; id++ {
+			members = append(members, `{"user":{"id":"`+strconv.Itoa(id)+`"}}`)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[" + strings.Join(members, ",") + "]"))
+	}))
+	defer server.Close()
+
+	members := NewMembers(NewClient("token", WithURL(server.URL)))
+
+	all, err := members.GetMembersIterator(1).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, all, 2500)
+	assert.Equal(t, snowflake.ID(2500), all[2499].User.ID)
+	assert.Equal(t, []string{"after=0&limit=1000", "after=1000&limit=1000", "after=2000&limit=1000"}, requests)
+
+	requests = nil
+	var ids []snowflake.ID
+	err = members.GetMembersIterator(1).Limit(1500).Prefetch(0).Each(context.Background(), func(member discord.Member) error {
+		ids = append(ids, member.User.ID)
+		if len(ids) == 1200 {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1200)
+	assert.Equal(t, []string{"after=0&limit=1000", "after=1000&limit=500"}, requests)
+
+	// without prefetching the next page is only fetched once the previous one was handled
+	requests = nil
+	err = members.GetMembersIterator(1).Prefetch(0).Each(context.Background(), func(member discord.Member) error {
+		if member.User.ID == 1000 {
+			time.Sleep(50 * time.Millisecond)
+			return ErrStopIteration
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"after=0&limit=1000"}, requests)
+
+	// by default only the page after the one currently handled is fetched
+	requests = nil
+	err = members.GetMembersIterator(1).Each(context.Background(), func(member discord.Member) error {
+		if member.User.ID == 1000 {
+			time.Sleep(50 * time.Millisecond)
+			return ErrStopIteration
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"after=0&limit=1000", "after=1000&limit=1000"}, requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	items, errFunc := members.GetMembersIterator(1).Chan(ctx)
+	for range items {
+	}
+	assert.ErrorIs(t, errFunc(), context.Canceled)
+}
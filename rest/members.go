@@ -14,6 +14,7 @@ func NewMembers(client Client) Members {
 type Members interface {
 	GetMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (*discord.Member, error)
 	GetMembers(guildID snowflake.ID, limit int, after snowflake.ID, opts ...RequestOpt) ([]discord.Member, error)
+	GetMembersIterator(guildID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Member]
 	SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) ([]discord.Member, error)
 	AddMember(guildID snowflake.ID, userID snowflake.ID, memberAdd discord.MemberAdd, opts ...RequestOpt) (*discord.Member, error)
 	RemoveMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
@@ -54,6 +55,19 @@ func (s *memberImpl) GetMembers(guildID snowflake.ID, limit int, after snowflake
 	return
 }
 
+func (s *memberImpl) GetMembersIterator(guildID snowflake.ID, opts ...RequestOpt) *Iterator[discord.Member] {
+	return newIterator(1000, opts, func() pager[discord.Member] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.Member, bool, error) {
+			members, err := s.GetMembers(guildID, limit, after, opts...)
+			if len(members) > 0 {
+				after = members[len(members)-1].User.ID
+			}
+			return members, len(members) == limit, err
+		}
+	})
+}
+
 func (s *memberImpl) SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) (members []discord.Member, err error) {
 	values := discord.QueryValues{}
 	if query != "" {
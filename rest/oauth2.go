@@ -21,6 +21,7 @@ type OAuth2 interface {
 	GetCurrentMember(bearerToken string, guildID snowflake.ID, opts ...RequestOpt) (*discord.Member, error)
 	GetCurrentUserGuilds(bearerToken string, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.OAuth2Guild, error)
 	GetCurrentUserGuildsPage(bearerToken string, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.OAuth2Guild]
+	GetCurrentUserGuildsIterator(bearerToken string, opts ...RequestOpt) *Iterator[discord.OAuth2Guild]
 	GetCurrentUserConnections(bearerToken string, opts ...RequestOpt) ([]discord.Connection, error)
 
 	SetGuildCommandPermissions(bearerToken string, applicationID snowflake.ID, guildID snowflake.ID, commandID snowflake.ID, commandPermissions []discord.ApplicationCommandPermission, opts ...RequestOpt) (*discord.ApplicationCommandPermissions, error)
@@ -87,6 +88,19 @@ func (s *oAuth2Impl) GetCurrentUserGuildsPage(bearerToken string, startID snowfl
 	}
 }
 
+func (s *oAuth2Impl) GetCurrentUserGuildsIterator(bearerToken string, opts ...RequestOpt) *Iterator[discord.OAuth2Guild] {
+	return newIterator(200, opts, func() pager[discord.OAuth2Guild] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.OAuth2Guild, bool, error) {
+			guilds, err := s.GetCurrentUserGuilds(bearerToken, 0, after, limit, opts...)
+			if len(guilds) > 0 {
+				after = guilds[len(guilds)-1].ID
+			}
+			return guilds, len(guilds) == limit, err
+		}
+	})
+}
+
 func (s *oAuth2Impl) GetCurrentUserConnections(bearerToken string, opts ...RequestOpt) (connections []discord.Connection, err error) {
 	err = s.client.Do(GetCurrentUserConnections.Compile(nil), nil, &connections, withBearerToken(bearerToken, opts)...)
 	return
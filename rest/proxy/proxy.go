@@ -0,0 +1,120 @@
+// Package proxy provides an HTTP server which forwards Discord API requests with a bot token and applies the rate limits centrally.
+// This allows multiple services to share one bot token without hitting rate limits.
+//
+//	p := proxy.New(token)
+//	go http.ListenAndServe(":8080", p)
+//
+// Clients then send their requests to the Proxy instead of Discord. They don't need to know the bot token.
+// The API version is part of the forwarded path, so clients can use any version:
+//
+//	client := rest.NewClient("", rest.WithURL("http://localhost:8080"))
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// hopHeaders are headers which are not forwarded. See https://www.rfc-editor.org/rfc/rfc9110#section-7.6.1
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// Proxy is a http.Handler which forwards all requests to Discord with the bot token while respecting the rate limits.
+type Proxy interface {
+	http.Handler
+
+	// Close closes the Proxy and waits for all pending requests to finish. You can use a cancelling context to abort the waiting.
+	Close(ctx context.Context)
+}
+
+// New returns a new Proxy which uses the given bot token & ConfigOpt(s).
+func New(botToken string, opts ...ConfigOpt) Proxy {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &proxyImpl{
+		botToken: botToken,
+		config:   *config,
+	}
+}
+
+type proxyImpl struct {
+	botToken string
+	config   Config
+	routes   routes
+}
+
+func (p *proxyImpl) Close(ctx context.Context) {
+	p.config.RateLimiter.Close(ctx)
+	p.config.HTTPClient.CloseIdleConnections()
+}
+
+func (p *proxyImpl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := p.routes.compile(p.config.URL, r.Method, r.URL.Path, r.URL.RawQuery)
+
+	rq, err := http.NewRequestWithContext(r.Context(), r.Method, endpoint.URL, r.Body)
+	if err != nil {
+		p.config.Logger.Errorf("failed to create proxy request: %s", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rq.ContentLength = r.ContentLength
+	rq.Header = r.Header.Clone()
+	for _, header := range hopHeaders {
+		rq.Header.Del(header)
+	}
+	// clients without a token still send an empty bot token
+	if auth := rq.Header.Get("Authorization"); !p.config.AllowClientAuthHeaders || strings.TrimSpace(strings.TrimPrefix(auth, string(discord.TokenTypeBot))) == "" {
+		rq.Header.Set("Authorization", discord.TokenTypeBot.Apply(p.botToken))
+	}
+	if p.config.UserAgent != "" {
+		rq.Header.Set("User-Agent", p.config.UserAgent)
+	}
+
+	if err = p.config.RateLimiter.WaitBucket(r.Context(), endpoint); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			status = http.StatusGatewayTimeout
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	rs, err := p.config.HTTPClient.Do(rq)
+	if err != nil {
+		_ = p.config.RateLimiter.UnlockBucket(endpoint, nil)
+		p.config.Logger.Errorf("failed to forward request to %s: %s", endpoint.URL, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer rs.Body.Close()
+
+	if err = p.config.RateLimiter.UnlockBucket(endpoint, rs); err != nil {
+		p.config.Logger.Errorf("failed to unlock rate limit bucket: %s", err)
+	}
+	p.config.Logger.Tracef("forwarded request to %s, code %d", endpoint.URL, rs.StatusCode)
+
+	for _, header := range hopHeaders {
+		rs.Header.Del(header)
+	}
+	for key, values := range rs.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(rs.StatusCode)
+	if _, err = io.Copy(w, rs.Body); err != nil {
+		p.config.Logger.Errorf("failed to write proxy response: %s", err)
+	}
+}
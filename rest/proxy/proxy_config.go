@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/log"
+)
+
+// DefaultConfig is the configuration which is used by default
+func DefaultConfig() *Config {
+	return &Config{
+		Logger:     log.Default(),
+		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+		URL:        rest.BaseURL,
+	}
+}
+
+// Config is the configuration for the Proxy
+type Config struct {
+	Logger                 log.Logger
+	HTTPClient             *http.Client
+	RateLimiter            rest.RateLimiter
+	RateLimiterConfigOpts  []rest.RateLimiterConfigOpt
+	URL                    string
+	UserAgent              string
+	AllowClientAuthHeaders bool
+}
+
+// ConfigOpt can be used to supply optional parameters to New
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.RateLimiter == nil {
+		c.RateLimiter = rest.NewRateLimiter(c.RateLimiterConfigOpts...)
+	}
+}
+
+// WithLogger applies a custom logger to the Proxy
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithHTTPClient applies a custom http.Client which is used to forward requests to Discord
+func WithHTTPClient(httpClient *http.Client) ConfigOpt {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+// WithRateLimiter applies a custom rest.RateLimiter to the Proxy
+func WithRateLimiter(rateLimiter rest.RateLimiter) ConfigOpt {
+	return func(config *Config) {
+		config.RateLimiter = rateLimiter
+	}
+}
+
+// WithRateLimiterConfigOpts applies rest.RateLimiterConfigOpt(s) to the default rest.RateLimiter of the Proxy
+func WithRateLimiterConfigOpts(opts ...rest.RateLimiterConfigOpt) ConfigOpt {
+	return func(config *Config) {
+		config.RateLimiterConfigOpts = append(config.RateLimiterConfigOpts, opts...)
+	}
+}
+
+// WithURL sets the base url without the API version requests are forwarded to. Defaults to rest.BaseURL
+func WithURL(url string) ConfigOpt {
+	return func(config *Config) {
+		config.URL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithUserAgent overrides the user agent of all forwarded requests
+func WithUserAgent(userAgent string) ConfigOpt {
+	return func(config *Config) {
+		config.UserAgent = userAgent
+	}
+}
+
+// WithAllowClientAuthHeaders keeps the Authorization header of incoming requests instead of always replacing it with the bot token.
+// This allows clients to use bearer tokens through the Proxy.
+func WithAllowClientAuthHeaders() ConfigOpt {
+	return func(config *Config) {
+		config.AllowClientAuthHeaders = true
+	}
+}
@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeRoute(t *testing.T) {
+	data := []struct {
+		path        string
+		route       string
+		majorParams string
+	}{
+		{"/channels/123/messages/456", "/channels/{channel.id}/messages/{id}", "channel.id=123"},
+		{"/channels/123/messages/456/reactions/%F0%9F%91%8D/@me", "/channels/{channel.id}/messages/{id}/reactions/{emoji}/@me", "channel.id=123"},
+		{"/webhooks/123/token/messages/@original", "/webhooks/{webhook.id}/{webhook.token}/messages/@original", "webhook.id=123"},
+		{"/webhooks/123/aW50ZXJhY3Rpb246MTIzOnRva2Vu/messages/@original", "/webhooks/{application.id}/{interaction.token}/messages/@original", "interaction.token=aW50ZXJhY3Rpb246MTIzOnRva2Vu"},
+		{"/interactions/123/token/callback", "/interactions/{interaction.id}/{interaction.token}/callback", "interaction.token=token"},
+		{"/gateway/bot", "/gateway/bot", ""},
+	}
+	for _, d := range data {
+		route, majorParams := normalizeRoute(d.path)
+		assert.Equal(t, d.route, route, d.path)
+		assert.Equal(t, d.majorParams, majorParams, d.path)
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var rq *http.Request
+	discord := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rq = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"456","channel_id":"123","content":"test"}`))
+	}))
+	defer discord.Close()
+
+	proxy := httptest.NewServer(New("token", WithURL(discord.URL)))
+	defer proxy.Close()
+
+	client := rest.New(rest.NewClient("", rest.WithURL(proxy.URL)))
+	message, err := client.GetMessage(snowflake.ID(123), snowflake.ID(456))
+	assert.NoError(t, err)
+	assert.Equal(t, "test", message.Content)
+
+	assert.Equal(t, "/v10/channels/123/messages/456", rq.URL.Path)
+	assert.Equal(t, "Bot token", rq.Header.Get("Authorization"))
+}
+
+type recordingRateLimiter struct {
+	rest.RateLimiter
+	endpoints []*rest.CompiledEndpoint
+}
+
+func (r *recordingRateLimiter) WaitBucket(ctx context.Context, endpoint *rest.CompiledEndpoint) error {
+	r.endpoints = append(r.endpoints, endpoint)
+	return r.RateLimiter.WaitBucket(ctx, endpoint)
+}
+
+func TestProxyInteractionCallback(t *testing.T) {
+	var rq *http.Request
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rq = r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer upstream.Close()
+
+	rateLimiter := &recordingRateLimiter{RateLimiter: rest.NewRateLimiter()}
+	proxy := httptest.NewServer(New("token", WithURL(upstream.URL), WithRateLimiter(rateLimiter)))
+	defer proxy.Close()
+
+	client := rest.New(rest.NewClient("", rest.WithURL(proxy.URL)))
+	err := client.CreateInteractionResponse(snowflake.ID(123), "token", discord.InteractionResponse{Type: discord.InteractionResponseTypeDeferredCreateMessage})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/v10/interactions/123/token/callback", rq.URL.Path)
+	if assert.Len(t, rateLimiter.endpoints, 1) {
+		assert.Equal(t, "/interactions/{interaction.id}/{interaction.token}/callback", rateLimiter.endpoints[0].Endpoint.Route)
+		assert.Equal(t, "interaction.token=token", rateLimiter.endpoints[0].MajorParams)
+	}
+}
@@ -0,0 +1,122 @@
+package proxy
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/disgo/rest"
+)
+
+// routes caches the rest.Endpoint(s) of all seen routes, as rest.RateLimiter(s) may identify routes by their *rest.Endpoint.
+type routes struct {
+	endpoints sync.Map
+}
+
+// compile turns the path of an incoming request into a rest.CompiledEndpoint.
+// IDs & tokens in the path are replaced with parameters, so requests to the same route share one rest.Endpoint & rate limit bucket.
+func (r *routes) compile(baseURL string, method string, path string, query string) *rest.CompiledEndpoint {
+	route, majorParams := normalizeRoute(trimAPIVersion(path))
+
+	key := method + "+" + route
+	endpoint, ok := r.endpoints.Load(key)
+	if !ok {
+		endpoint, _ = r.endpoints.LoadOrStore(key, rest.NewEndpoint(method, route))
+	}
+
+	url := baseURL + path
+	if query != "" {
+		url += "?" + query
+	}
+	return &rest.CompiledEndpoint{
+		Endpoint:    endpoint.(*rest.Endpoint),
+		URL:         url,
+		MajorParams: majorParams,
+	}
+}
+
+// trimAPIVersion removes the API version prefix of the path, as the routes of rest.Endpoint(s) don't contain it.
+func trimAPIVersion(path string) string {
+	version, route, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(version, "v") {
+		return path
+	}
+	if _, err := strconv.Atoi(version[1:]); err != nil {
+		return path
+	}
+	return "/" + route
+}
+
+// normalizeRoute returns the route of the given path with parameters instead of IDs & tokens and the major parameters of the path.
+func normalizeRoute(path string) (string, string) {
+	original := strings.Split(strings.Trim(path, "/"), "/")
+	segments := make([]string, len(original))
+	copy(segments, original)
+
+	var majorParams []string
+	for i, segment := range original {
+		var prev string
+		if i > 0 {
+			prev = original[i-1]
+		}
+
+		param := ""
+		switch {
+		case prev == "reactions":
+			param = "emoji"
+		case i > 1 && original[i-2] == "webhooks" && isSnowflake(prev) && isInteractionToken(segment):
+			param = "interaction.token"
+		case i > 1 && original[i-2] == "webhooks" && isSnowflake(prev):
+			param = "webhook.token"
+		case i > 1 && original[i-2] == "interactions" && isSnowflake(prev):
+			param = "interaction.token"
+		case !isSnowflake(segment):
+			continue
+		case prev == "channels":
+			param = "channel.id"
+		case prev == "guilds":
+			param = "guild.id"
+		case prev == "webhooks" && i+1 < len(original) && isInteractionToken(original[i+1]):
+			// interaction responses & followups are sent to /webhooks/{application.id}/{interaction.token}
+			param = "application.id"
+		case prev == "webhooks":
+			param = "webhook.id"
+		case prev == "interactions":
+			param = "interaction.id"
+		default:
+			param = "id"
+		}
+
+		if isMajorParameter(param) {
+			majorParams = append(majorParams, param+"="+segment)
+		}
+		segments[i] = "{" + param + "}"
+	}
+	return "/" + strings.Join(segments, "/"), strings.Join(majorParams, ":")
+}
+
+func isMajorParameter(param string) bool {
+	for _, majorParam := range strings.Split(rest.MajorParameters, ":") {
+		if majorParam == param {
+			return true
+		}
+	}
+	return false
+}
+
+// isInteractionToken returns whether the token is an interaction token. Interaction tokens are base64 encoded & start with "interaction:".
+func isInteractionToken(token string) bool {
+	return strings.HasPrefix(token, "aW50ZXJhY3Rpb246")
+}
+
+func isSnowflake(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -24,6 +24,12 @@ type RequestConfig struct {
 	Ctx     context.Context
 	Checks  []Check
 	Delay   time.Duration
+	// Priority is the Priority of the request in the RateLimiter. If nil, interaction responses & followups use PriorityHigh & other requests PriorityNormal
+	Priority *Priority
+	// RetryPolicy overrides the RetryPolicy of the Client for the request
+	RetryPolicy *RetryPolicy
+	// Idempotent marks the request as safe to retry, even if its method is not idempotent
+	Idempotent bool
 }
 
 // Check is a function which gets executed right before a request is made
@@ -40,6 +46,9 @@ func (c *RequestConfig) Apply(opts []RequestOpt) {
 	if c.Ctx == nil {
 		c.Ctx = context.TODO()
 	}
+	if c.Priority != nil {
+		c.Ctx = WithPriorityContext(c.Ctx, *c.Priority)
+	}
 }
 
 // WithCtx applies a custom context to the request
@@ -49,6 +58,27 @@ func WithCtx(ctx context.Context) RequestOpt {
 	}
 }
 
+// WithPriority sets the Priority of the request in the RateLimiter
+func WithPriority(priority Priority) RequestOpt {
+	return func(config *RequestConfig) {
+		config.Priority = &priority
+	}
+}
+
+// WithRequestRetryPolicy overrides the RetryPolicy of the Client for the request
+func WithRequestRetryPolicy(retryPolicy RetryPolicy) RequestOpt {
+	return func(config *RequestConfig) {
+		config.RetryPolicy = &retryPolicy
+	}
+}
+
+// WithIdempotent marks the request as safe to retry on network errors or 5xx responses, even if it is a POST or PATCH request
+func WithIdempotent() RequestOpt {
+	return func(config *RequestConfig) {
+		config.Idempotent = true
+	}
+}
+
 // WithCheck adds a new check to the request
 func WithCheck(check Check) RequestOpt {
 	return func(config *RequestConfig) {
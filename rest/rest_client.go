@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/tracing"
 	"github.com/disgoorg/json"
 )
 
@@ -20,7 +21,25 @@ func NewClient(botToken string, opts ...ConfigOpt) Client {
 
 	config.RateLimiter.Reset()
 
-	return &clientImpl{botToken: botToken, config: *config}
+	client := &clientImpl{botToken: botToken, config: *config, apiURL: APIURL(config.URL, config.APIVersion)}
+	client.roundTrip = chainMiddlewares(client.doRequest, config.Middlewares)
+	return client
+}
+
+// NewContextClient returns a Client which does all requests with the given context.Context unless they set their own with WithCtx.
+// Wrap it with New to get a Rest doing so, like events.GenericEvent.Rest does.
+func NewContextClient(client Client, ctx context.Context) Client {
+	return &contextClient{Client: client, ctx: ctx}
+}
+
+type contextClient struct {
+	Client
+	ctx context.Context
+}
+
+func (c *contextClient) Do(endpoint *CompiledEndpoint, rqBody any, rsBody any, opts ...RequestOpt) error {
+	// prepend the context, so it can be overridden
+	return c.Client.Do(endpoint, rqBody, rsBody, append([]RequestOpt{WithCtx(c.ctx)}, opts...)...)
 }
 
 // Client allows doing requests to different endpoints
@@ -39,8 +58,10 @@ type Client interface {
 }
 
 type clientImpl struct {
-	botToken string
-	config   Config
+	botToken  string
+	config    Config
+	apiURL    string
+	roundTrip RoundTripFunc
 }
 
 func (c *clientImpl) Close(ctx context.Context) {
@@ -56,7 +77,20 @@ func (c *clientImpl) RateLimiter() RateLimiter {
 	return c.config.RateLimiter
 }
 
-func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, opts []RequestOpt) error {
+func (c *clientImpl) doRequest(rq *Request) (*http.Response, error) {
+	return c.HTTPClient().Do(rq.Request)
+}
+
+// requestState keeps track of a request across all its retries.
+type requestState struct {
+	ctx        context.Context
+	span       tracing.Span
+	attempts   int
+	statusCode int
+}
+
+// retry does the request & retries it on rate limits or if the RetryPolicy allows it.
+func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, retries int, state *requestState, opts []RequestOpt) error {
 	var (
 		rawRqBody   []byte
 		err         error
@@ -100,6 +134,15 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 	config := DefaultRequestConfig(rq)
 	config.Apply(opts)
 
+	// start one span for the request including all retries
+	if state.span == nil {
+		state.ctx, state.span = c.config.Tracer.Start(config.Ctx, endpoint.Endpoint.Method+" "+endpoint.Endpoint.Route,
+			tracing.String("http.method", endpoint.Endpoint.Method),
+			tracing.String("http.route", endpoint.Endpoint.Route),
+		)
+	}
+	config.Ctx = state.ctx
+
 	if config.Delay > 0 {
 		timer := time.NewTimer(config.Delay)
 		defer timer.Stop()
@@ -115,7 +158,8 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 	if err != nil {
 		return fmt.Errorf("error locking bucket in rest client: %w", err)
 	}
-	rq = rq.WithContext(config.Ctx)
+	config.Request = config.Request.WithContext(config.Ctx)
+	rq = config.Request
 
 	for _, check := range config.Checks {
 		if !check() {
@@ -124,11 +168,38 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 		}
 	}
 
-	rs, err := c.HTTPClient().Do(config.Request)
+	retryPolicy := c.config.RetryPolicy
+	if config.RetryPolicy != nil {
+		retryPolicy = *config.RetryPolicy
+	}
+	canRetry := retries < retryPolicy.MaxRetries && retryPolicy.canRetry(endpoint.Endpoint.Method, config.Idempotent)
+
+	state.attempts++
+	start := time.Now()
+	rs, err := c.roundTrip(&Request{
+		Endpoint: endpoint,
+		Body:     rawRqBody,
+		Request:  config.Request,
+		Attempt:  state.attempts,
+	})
 	if err != nil {
+		c.config.Metrics.RESTRequest(endpoint.Endpoint.Method, endpoint.Endpoint.Route, 0, time.Since(start))
 		_ = c.RateLimiter().UnlockBucket(endpoint, nil)
+		// don't retry if the request was cancelled
+		if canRetry && config.Ctx.Err() == nil {
+			c.config.Logger.Debugf("error doing request to %s, retrying: %s", endpoint.URL, err)
+			if err = retryPolicy.wait(config.Ctx, retries+1); err != nil {
+				return err
+			}
+			return c.retry(endpoint, rqBody, rsBody, tries, retries+1, state, opts)
+		}
 		return fmt.Errorf("error doing request in rest client: %w", err)
 	}
+	if rs.Body != nil {
+		defer rs.Body.Close()
+	}
+	state.statusCode = rs.StatusCode
+	c.config.Metrics.RESTRequest(endpoint.Endpoint.Method, endpoint.Endpoint.Route, rs.StatusCode, time.Since(start))
 
 	if err = c.RateLimiter().UnlockBucket(endpoint, rs); err != nil {
 		return fmt.Errorf("error unlocking bucket in rest client: %w", err)
@@ -154,16 +225,40 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 		return nil
 
 	case http.StatusTooManyRequests:
+		c.config.Metrics.RESTRateLimited(endpoint.Endpoint.Method, endpoint.Endpoint.Route, rs.Header.Get("X-RateLimit-Scope"))
 		if tries >= c.RateLimiter().MaxRetries() {
 			return NewError(rq, rawRqBody, rs, rawRsBody)
 		}
-		return c.retry(endpoint, rqBody, rsBody, tries+1, opts)
+		return c.retry(endpoint, rqBody, rsBody, tries+1, retries, state, opts)
 
 	default:
+		if rs.StatusCode >= http.StatusInternalServerError && canRetry {
+			c.config.Logger.Debugf("request to %s failed with status %d, retrying", endpoint.URL, rs.StatusCode)
+			if err = retryPolicy.wait(config.Ctx, retries+1); err != nil {
+				return err
+			}
+			return c.retry(endpoint, rqBody, rsBody, tries, retries+1, state, opts)
+		}
 		return NewError(rq, rawRqBody, rs, rawRsBody)
 	}
 }
 
 func (c *clientImpl) Do(endpoint *CompiledEndpoint, rqBody any, rsBody any, opts ...RequestOpt) error {
-	return c.retry(endpoint, rqBody, rsBody, 1, opts)
+	// endpoints compiled with the default API base path use the one of this client instead
+	if c.apiURL != API && endpoint.URL == API+endpoint.Path {
+		endpoint = endpoint.WithAPIURL(c.apiURL)
+	}
+	var state requestState
+	err := c.retry(endpoint, rqBody, rsBody, 1, 0, &state, opts)
+	if err != nil && state.attempts > 1 {
+		err = &RetryError{Attempts: state.attempts, Err: err}
+	}
+	if state.span != nil {
+		state.span.SetAttributes(tracing.Int("http.status_code", state.statusCode), tracing.Int("disgo.attempts", state.attempts))
+		if err != nil {
+			state.span.RecordError(err)
+		}
+		state.span.End()
+	}
+	return err
 }
@@ -0,0 +1,173 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/tracing"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientURL(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.RequestURI()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("token", WithURL(server.URL+"/api/"), WithAPIVersion(9))
+	err := client.Do(DeleteMessage.Compile(nil, snowflake.ID(1), snowflake.ID(2)), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v9/channels/1/messages/2", path)
+
+	compiled := GetMessages.CompileURL("http://localhost/api/v8", map[string]any{"limit": 10}, snowflake.ID(1))
+	assert.Equal(t, "/channels/1/messages?limit=10", compiled.Path)
+	assert.Equal(t, "http://localhost/api/v8/channels/1/messages?limit=10", compiled.URL)
+}
+
+func TestClientRetry(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("token", WithURL(server.URL), WithRetryPolicy(RetryPolicy{MaxRetries: 2}))
+	err := client.Do(DeleteMessage.Compile(nil, snowflake.ID(1), snowflake.ID(2)), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	// POST requests are not retried by default
+	requests = 0
+	err = client.Do(CreateMessage.Compile(nil, snowflake.ID(1)), discord.MessageCreate{Content: "test"}, nil)
+	var restErr *Error
+	assert.ErrorAs(t, err, &restErr)
+	assert.Equal(t, 1, requests)
+
+	requests = 0
+	err = client.Do(CreateMessage.Compile(nil, snowflake.ID(1)), discord.MessageCreate{Content: "test"}, nil, WithIdempotent())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	requests = 0
+	err = client.Do(DeleteMessage.Compile(nil, snowflake.ID(1), snowflake.ID(2)), nil, nil)
+	var retryErr *RetryError
+	if assert.ErrorAs(t, err, &retryErr) {
+		assert.Equal(t, 3, retryErr.Attempts)
+	}
+	assert.ErrorAs(t, err, &restErr)
+	assert.Equal(t, 3, requests)
+}
+
+func TestClientMiddlewares(t *testing.T) {
+	var calls []string
+	client := NewClient("token", WithMiddlewares(
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(rq *Request) (*http.Response, error) {
+				calls = append(calls, "outer")
+				rq.Request.Header.Set("X-Test", "test")
+				rs, err := next(rq)
+				calls = append(calls, "outer "+rs.Status)
+				return rs, err
+			}
+		},
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(rq *Request) (*http.Response, error) {
+				calls = append(calls, "inner "+rq.Endpoint.Endpoint.Route+" "+string(rq.Body)+" "+rq.Request.Header.Get("X-Test"))
+				rs := httptest.NewRecorder()
+				rs.WriteHeader(http.StatusOK)
+				_, _ = rs.WriteString(`{"id":"1"}`)
+				return rs.Result(), nil
+			}
+		},
+	))
+
+	var message discord.Message
+	err := client.Do(CreateMessage.Compile(nil, snowflake.ID(1)), discord.MessageCreate{Content: "test"}, &message)
+	assert.NoError(t, err)
+	assert.Equal(t, snowflake.ID(1), message.ID)
+	assert.Equal(t, []string{"outer", `inner /channels/{channel.id}/messages {"content":"test"} test`, "outer 200 OK"}, calls)
+}
+
+type testSpanKey struct{}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attributes ...tracing.Attribute) (context.Context, tracing.Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attributes: attributes}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+type testSpan struct {
+	name       string
+	parent     *testSpan
+	attributes []tracing.Attribute
+	err        error
+	ended      bool
+}
+
+func (s *testSpan) SetAttributes(attributes ...tracing.Attribute) {
+	s.attributes = append(s.attributes, attributes...)
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+func TestClientTracer(t *testing.T) {
+	var rqSpan *testSpan
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	client := NewClient("token", WithURL(server.URL), WithTracer(tracer), WithMiddlewares(func(next RoundTripFunc) RoundTripFunc {
+		return func(rq *Request) (*http.Response, error) {
+			rqSpan, _ = rq.Request.Context().Value(testSpanKey{}).(*testSpan)
+			return next(rq)
+		}
+	}))
+
+	ctx, parent := tracer.Start(context.Background(), "event")
+	err := client.Do(DeleteMessage.Compile(nil, snowflake.ID(1), snowflake.ID(2)), nil, nil, WithCtx(ctx))
+	assert.Error(t, err)
+
+	if assert.Len(t, tracer.spans, 2) {
+		span := tracer.spans[1]
+		assert.Equal(t, "DELETE /channels/{channel.id}/messages/{message.id}", span.name)
+		assert.Same(t, parent, span.parent)
+		assert.Same(t, span, rqSpan)
+		assert.Contains(t, span.attributes, tracing.Int("http.status_code", http.StatusNotFound))
+		assert.Equal(t, err, span.err)
+		assert.True(t, span.ended)
+	}
+
+	// requests of a context client use its context.Context by default
+	assert.Error(t, New(NewContextClient(client, ctx)).DeleteMessage(1, 2))
+	if assert.Len(t, tracer.spans, 3) {
+		assert.Same(t, parent, tracer.spans[2].parent)
+	}
+}
@@ -2,16 +2,24 @@ package rest
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/disgoorg/disgo/metrics"
+	"github.com/disgoorg/disgo/tracing"
 	"github.com/disgoorg/log"
 )
 
 // DefaultConfig is the configuration which is used by default
 func DefaultConfig() *Config {
 	return &Config{
-		Logger:     log.Default(),
-		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+		Logger:      log.Default(),
+		HTTPClient:  &http.Client{Timeout: 20 * time.Second},
+		URL:         BaseURL,
+		APIVersion:  APIVersion,
+		RetryPolicy: DefaultRetryPolicy(),
+		Metrics:     metrics.Noop,
+		Tracer:      tracing.Noop,
 	}
 }
 
@@ -22,6 +30,12 @@ type Config struct {
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
 	UserAgent                 string
+	URL                       string
+	APIVersion                int
+	RetryPolicy               RetryPolicy
+	Middlewares               []Middleware
+	Metrics                   metrics.Metrics
+	Tracer                    tracing.Tracer
 }
 
 // ConfigOpt can be used to supply optional parameters to NewClient
@@ -33,7 +47,7 @@ func (c *Config) Apply(opts []ConfigOpt) {
 		opt(c)
 	}
 	if c.RateLimiter == nil {
-		c.RateLimiter = NewRateLimiter(c.RateRateLimiterConfigOpts...)
+		c.RateLimiter = NewRateLimiter(append([]RateLimiterConfigOpt{WithRateLimiterMetrics(c.Metrics)}, c.RateRateLimiterConfigOpts...)...)
 	}
 }
 
@@ -71,3 +85,46 @@ func WithUserAgent(userAgent string) ConfigOpt {
 		config.UserAgent = userAgent
 	}
 }
+
+// WithURL sets the base url without the API version all requests are sent to instead of BaseURL.
+// This can be used to send requests to a mock server, a staging environment or through a proxy like rest/proxy.
+func WithURL(url string) ConfigOpt {
+	return func(config *Config) {
+		config.URL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithAPIVersion sets the Discord API version all requests use instead of APIVersion
+func WithAPIVersion(version int) ConfigOpt {
+	return func(config *Config) {
+		config.APIVersion = version
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy for requests which failed due to a network error or a 5xx response
+func WithRetryPolicy(retryPolicy RetryPolicy) ConfigOpt {
+	return func(config *Config) {
+		config.RetryPolicy = retryPolicy
+	}
+}
+
+// WithMiddlewares appends the given Middleware(s) which wrap the round trip of every request. They are executed in the order they were added.
+func WithMiddlewares(middlewares ...Middleware) ConfigOpt {
+	return func(config *Config) {
+		config.Middlewares = append(config.Middlewares, middlewares...)
+	}
+}
+
+// WithMetrics sets the metrics.Metrics which records the latency & status of all requests. It is also used by the default RateLimiter.
+func WithMetrics(m metrics.Metrics) ConfigOpt {
+	return func(config *Config) {
+		config.Metrics = m
+	}
+}
+
+// WithTracer sets the tracing.Tracer which creates a span for every request as child of the span in the context of the request. See WithCtx
+func WithTracer(tracer tracing.Tracer) ConfigOpt {
+	return func(config *Config) {
+		config.Tracer = tracer
+	}
+}
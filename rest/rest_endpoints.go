@@ -12,10 +12,18 @@ var (
 	// APIVersion is the Discord API version DisGo should use
 	APIVersion = 10
 
+	// BaseURL is the base url of the Discord API without the version
+	BaseURL = "https://discord.com/api"
+
 	// API is the base path of the Discord API
-	API = fmt.Sprintf("https://discord.com/api/v%d", APIVersion)
+	API = APIURL(BaseURL, APIVersion)
 )
 
+// APIURL returns the base path of the API at the given base url with the given version
+func APIURL(baseURL string, version int) string {
+	return fmt.Sprintf("%s/v%d", strings.TrimSuffix(baseURL, "/"), version)
+}
+
 // MajorParameters is a list of url parameters which decide in which bucket a route belongs (https://discord.com/developers/docs/topics/rate-limits#rate-limits)
 const MajorParameters = "guild.id:channel.id:webhook.id:interaction.token"
 
@@ -312,12 +320,26 @@ type Endpoint struct {
 type CompiledEndpoint struct {
 	Endpoint *Endpoint
 
+	// Path is the path of the endpoint with applied url params & query values, without the base path of the API
+	Path        string
 	URL         string
 	MajorParams string
 }
 
-// Compile compiles an Endpoint to a CompiledEndpoint with the given url params & query values
+// WithAPIURL returns a copy of the CompiledEndpoint which URL uses the given base path of the API
+func (e *CompiledEndpoint) WithAPIURL(apiURL string) *CompiledEndpoint {
+	compiledEndpoint := *e
+	compiledEndpoint.URL = apiURL + e.Path
+	return &compiledEndpoint
+}
+
+// Compile compiles an Endpoint to a CompiledEndpoint with the given url params & query values using the default API base path
 func (e *Endpoint) Compile(values discord.QueryValues, params ...any) *CompiledEndpoint {
+	return e.CompileURL(API, values, params...)
+}
+
+// CompileURL compiles an Endpoint to a CompiledEndpoint with the given API base path, url params & query values
+func (e *Endpoint) CompileURL(apiURL string, values discord.QueryValues, params ...any) *CompiledEndpoint {
 	var majorParams []string
 	path := e.Route
 	for _, param := range params {
@@ -341,7 +363,8 @@ func (e *Endpoint) Compile(values discord.QueryValues, params ...any) *CompiledE
 
 	return &CompiledEndpoint{
 		Endpoint:    e,
-		URL:         API + path + query,
+		Path:        path + query,
+		URL:         apiURL + path + query,
 		MajorParams: strings.Join(majorParams, ":"),
 	}
 }
@@ -3,6 +3,10 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/disgoorg/json"
 )
 
 var _ error = (*Error)(nil)
@@ -13,20 +17,105 @@ type Error struct {
 	RqBody   []byte
 	Response *http.Response
 	RsBody   []byte
+
+	// Code is the JSONErrorCode Discord returned. It is 0 if the response body did not contain a JSON error or for general errors.
+	Code JSONErrorCode
+	// Message is the error message Discord returned.
+	Message string
+	// Errors contains the field level errors Discord returned, for example when the request body was invalid.
+	Errors []FieldError
+}
+
+// FieldError is a field level error of an Error.
+type FieldError struct {
+	// Path is the dot separated path to the field the error is about, for example embeds.0.title
+	Path    string
+	Code    string
+	Message string
+}
+
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s: %s (%s)", e.Path, e.Message, e.Code)
 }
 
 // NewError returns a new Error with the given http.Request, http.Response
 func NewError(rq *http.Request, rqBody []byte, rs *http.Response, rsBody []byte) error {
-	return &Error{
+	err := &Error{
 		Request:  rq,
 		RqBody:   rqBody,
 		Response: rs,
 		RsBody:   rsBody,
 	}
+	err.parseBody()
+	return err
 }
 
-// Is returns true if the error is a discord.APIError 6 has the same StatusCode
+// parseBody parses the Discord JSON error from the response body if it is one
+func (e *Error) parseBody() {
+	var v struct {
+		Code    JSONErrorCode   `json:"code"`
+		Message string          `json:"message"`
+		Errors  json.RawMessage `json:"errors"`
+	}
+	if len(e.RsBody) == 0 || json.Unmarshal(e.RsBody, &v) != nil {
+		return
+	}
+	e.Code = v.Code
+	e.Message = v.Message
+
+	if len(v.Errors) == 0 {
+		return
+	}
+	var errs map[string]json.RawMessage
+	if json.Unmarshal(v.Errors, &errs) != nil {
+		return
+	}
+	e.Errors = flattenFieldErrors("", errs)
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		return e.Errors[i].Path < e.Errors[j].Path
+	})
+}
+
+// flattenFieldErrors walks the nested errors object Discord returns & collects the _errors arrays with the path they are found under.
+func flattenFieldErrors(path string, errs map[string]json.RawMessage) []FieldError {
+	var fieldErrors []FieldError
+	for key, value := range errs {
+		if key == "_errors" {
+			var v []struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			}
+			if json.Unmarshal(value, &v) != nil {
+				continue
+			}
+			for _, err := range v {
+				fieldErrors = append(fieldErrors, FieldError{
+					Path:    path,
+					Code:    err.Code,
+					Message: err.Message,
+				})
+			}
+			continue
+		}
+
+		var nested map[string]json.RawMessage
+		if json.Unmarshal(value, &nested) != nil {
+			continue
+		}
+		nestedPath := key
+		if path != "" {
+			nestedPath = path + "." + key
+		}
+		fieldErrors = append(fieldErrors, flattenFieldErrors(nestedPath, nested)...)
+	}
+	return fieldErrors
+}
+
+// Is returns true if the target is an Error with the same StatusCode or a JSONErrorCode which matches the Code of this Error
 func (e Error) Is(target error) bool {
+	if code, ok := target.(JSONErrorCode); ok {
+		return e.Code != 0 && e.Code == code
+	}
 	err, ok := target.(*Error)
 	if !ok {
 		return false
@@ -36,6 +125,17 @@ func (e Error) Is(target error) bool {
 
 // Error returns the error formatted as string
 func (e Error) Error() string {
+	if e.Code != 0 && e.Response != nil {
+		msg := fmt.Sprintf("Status: %s, Code: %d, Message: %s", e.Response.Status, e.Code, e.Message)
+		if len(e.Errors) > 0 {
+			errs := make([]string, len(e.Errors))
+			for i, err := range e.Errors {
+				errs[i] = err.String()
+			}
+			msg += ", Errors: " + strings.Join(errs, ", ")
+		}
+		return msg
+	}
 	if e.Response != nil {
 		return fmt.Sprintf("Status: %s, Body: %s", e.Response.Status, string(e.RsBody))
 	}
@@ -0,0 +1,370 @@
+package rest
+
+import "strconv"
+
+// JSONErrorCode is a JSON error code returned by Discord. See https://discord.com/developers/docs/topics/opcodes-and-status-codes#json-json-error-codes
+// JSONErrorCode implements error, which allows checking an Error for a specific code with errors.Is:
+//
+//	if errors.Is(err, rest.ErrUnknownMessage) {
+//		// message was already deleted
+//	}
+type JSONErrorCode int
+
+// Error returns the description of the JSONErrorCode
+func (c JSONErrorCode) Error() string {
+	if msg, ok := jsonErrorCodeMessages[c]; ok {
+		return msg
+	}
+	return "unknown json error code " + strconv.Itoa(int(c))
+}
+
+// JSONErrorCodes documented by Discord
+const (
+	ErrUnknownAccount                            JSONErrorCode = 10001
+	ErrUnknownApplication                        JSONErrorCode = 10002
+	ErrUnknownChannel                            JSONErrorCode = 10003
+	ErrUnknownGuild                              JSONErrorCode = 10004
+	ErrUnknownIntegration                        JSONErrorCode = 10005
+	ErrUnknownInvite                             JSONErrorCode = 10006
+	ErrUnknownMember                             JSONErrorCode = 10007
+	ErrUnknownMessage                            JSONErrorCode = 10008
+	ErrUnknownPermissionOverwrite                JSONErrorCode = 10009
+	ErrUnknownProvider                           JSONErrorCode = 10010
+	ErrUnknownRole                               JSONErrorCode = 10011
+	ErrUnknownToken                              JSONErrorCode = 10012
+	ErrUnknownUser                               JSONErrorCode = 10013
+	ErrUnknownEmoji                              JSONErrorCode = 10014
+	ErrUnknownWebhook                            JSONErrorCode = 10015
+	ErrUnknownWebhookService                     JSONErrorCode = 10016
+	ErrUnknownSession                            JSONErrorCode = 10020
+	ErrUnknownBan                                JSONErrorCode = 10026
+	ErrUnknownSKU                                JSONErrorCode = 10027
+	ErrUnknownStoreListing                       JSONErrorCode = 10028
+	ErrUnknownEntitlement                        JSONErrorCode = 10029
+	ErrUnknownBuild                              JSONErrorCode = 10030
+	ErrUnknownLobby                              JSONErrorCode = 10031
+	ErrUnknownBranch                             JSONErrorCode = 10032
+	ErrUnknownStoreDirectoryLayout               JSONErrorCode = 10033
+	ErrUnknownRedistributable                    JSONErrorCode = 10036
+	ErrUnknownGiftCode                           JSONErrorCode = 10038
+	ErrUnknownStream                             JSONErrorCode = 10049
+	ErrUnknownPremiumServerSubscribeCooldown     JSONErrorCode = 10050
+	ErrUnknownGuildTemplate                      JSONErrorCode = 10057
+	ErrUnknownDiscoverableServerCategory         JSONErrorCode = 10059
+	ErrUnknownSticker                            JSONErrorCode = 10060
+	ErrUnknownInteraction                        JSONErrorCode = 10062
+	ErrUnknownApplicationCommand                 JSONErrorCode = 10063
+	ErrUnknownVoiceState                         JSONErrorCode = 10065
+	ErrUnknownApplicationCommandPermissions      JSONErrorCode = 10066
+	ErrUnknownStageInstance                      JSONErrorCode = 10067
+	ErrUnknownGuildMemberVerificationForm        JSONErrorCode = 10068
+	ErrUnknownGuildWelcomeScreen                 JSONErrorCode = 10069
+	ErrUnknownGuildScheduledEvent                JSONErrorCode = 10070
+	ErrUnknownGuildScheduledEventUser            JSONErrorCode = 10071
+	ErrUnknownTag                                JSONErrorCode = 10087
+	ErrBotsCannotUseThisEndpoint                 JSONErrorCode = 20001
+	ErrOnlyBotsCanUseThisEndpoint                JSONErrorCode = 20002
+	ErrExplicitContentCannotBeSent               JSONErrorCode = 20009
+	ErrNotAuthorizedForApplication               JSONErrorCode = 20012
+	ErrSlowmodeRateLimit                         JSONErrorCode = 20016
+	ErrOnlyOwnerCanPerformAction                 JSONErrorCode = 20018
+	ErrAnnouncementEditRateLimit                 JSONErrorCode = 20022
+	ErrUnderMinimumAge                           JSONErrorCode = 20024
+	ErrChannelWriteRateLimit                     JSONErrorCode = 20028
+	ErrServerWriteRateLimit                      JSONErrorCode = 20029
+	ErrWordsNotAllowed                           JSONErrorCode = 20031
+	ErrGuildPremiumSubscriptionLevelTooLow       JSONErrorCode = 20035
+	ErrMaxGuilds                                 JSONErrorCode = 30001
+	ErrMaxFriends                                JSONErrorCode = 30002
+	ErrMaxPins                                   JSONErrorCode = 30003
+	ErrMaxRecipients                             JSONErrorCode = 30004
+	ErrMaxGuildRoles                             JSONErrorCode = 30005
+	ErrMaxWebhooks                               JSONErrorCode = 30007
+	ErrMaxEmojis                                 JSONErrorCode = 30008
+	ErrMaxReactions                              JSONErrorCode = 30010
+	ErrMaxGroupDMs                               JSONErrorCode = 30011
+	ErrMaxGuildChannels                          JSONErrorCode = 30013
+	ErrMaxAttachments                            JSONErrorCode = 30015
+	ErrMaxInvites                                JSONErrorCode = 30016
+	ErrMaxAnimatedEmojis                         JSONErrorCode = 30018
+	ErrMaxServerMembers                          JSONErrorCode = 30019
+	ErrMaxServerCategories                       JSONErrorCode = 30030
+	ErrGuildAlreadyHasTemplate                   JSONErrorCode = 30031
+	ErrMaxApplicationCommands                    JSONErrorCode = 30032
+	ErrMaxThreadParticipants                     JSONErrorCode = 30033
+	ErrMaxDailyApplicationCommandCreates         JSONErrorCode = 30034
+	ErrMaxNonGuildMemberBans                     JSONErrorCode = 30035
+	ErrMaxBanFetches                             JSONErrorCode = 30037
+	ErrMaxUncompletedGuildScheduledEvents        JSONErrorCode = 30038
+	ErrMaxStickers                               JSONErrorCode = 30039
+	ErrMaxPruneRequests                          JSONErrorCode = 30040
+	ErrMaxGuildWidgetSettingsUpdates             JSONErrorCode = 30042
+	ErrMaxEditsToOldMessages                     JSONErrorCode = 30046
+	ErrMaxPinnedThreadsInForum                   JSONErrorCode = 30047
+	ErrMaxForumTags                              JSONErrorCode = 30048
+	ErrBitrateTooHigh                            JSONErrorCode = 30052
+	ErrUnauthorized                              JSONErrorCode = 40001
+	ErrAccountVerificationRequired               JSONErrorCode = 40002
+	ErrOpeningDMsTooFast                         JSONErrorCode = 40003
+	ErrSendMessagesTemporarilyDisabled           JSONErrorCode = 40004
+	ErrRequestEntityTooLarge                     JSONErrorCode = 40005
+	ErrFeatureTemporarilyDisabled                JSONErrorCode = 40006
+	ErrUserBannedFromGuild                       JSONErrorCode = 40007
+	ErrConnectionRevoked                         JSONErrorCode = 40012
+	ErrTargetUserNotConnectedToVoice             JSONErrorCode = 40032
+	ErrMessageAlreadyCrossposted                 JSONErrorCode = 40033
+	ErrApplicationCommandAlreadyExists           JSONErrorCode = 40041
+	ErrApplicationInteractionFailedToSend        JSONErrorCode = 40043
+	ErrCannotSendMessageInForumChannel           JSONErrorCode = 40058
+	ErrInteractionAlreadyAcknowledged            JSONErrorCode = 40060
+	ErrTagNamesMustBeUnique                      JSONErrorCode = 40061
+	ErrMissingAccess                             JSONErrorCode = 50001
+	ErrInvalidAccountType                        JSONErrorCode = 50002
+	ErrCannotExecuteActionOnDMChannel            JSONErrorCode = 50003
+	ErrGuildWidgetDisabled                       JSONErrorCode = 50004
+	ErrCannotEditMessageByOtherUser              JSONErrorCode = 50005
+	ErrCannotSendEmptyMessage                    JSONErrorCode = 50006
+	ErrCannotSendMessagesToUser                  JSONErrorCode = 50007
+	ErrCannotSendMessagesInNonTextChannel        JSONErrorCode = 50008
+	ErrChannelVerificationLevelTooHigh           JSONErrorCode = 50009
+	ErrOAuth2ApplicationHasNoBot                 JSONErrorCode = 50010
+	ErrOAuth2ApplicationLimitReached             JSONErrorCode = 50011
+	ErrInvalidOAuth2State                        JSONErrorCode = 50012
+	ErrMissingPermissions                        JSONErrorCode = 50013
+	ErrInvalidAuthenticationToken                JSONErrorCode = 50014
+	ErrNoteTooLong                               JSONErrorCode = 50015
+	ErrInvalidBulkDeleteCount                    JSONErrorCode = 50016
+	ErrInvalidMFALevel                           JSONErrorCode = 50017
+	ErrCannotPinMessageInOtherChannel            JSONErrorCode = 50019
+	ErrInvalidInviteCode                         JSONErrorCode = 50020
+	ErrCannotExecuteActionOnSystemMessage        JSONErrorCode = 50021
+	ErrCannotExecuteActionOnChannelType          JSONErrorCode = 50024
+	ErrInvalidOAuth2AccessToken                  JSONErrorCode = 50025
+	ErrMissingOAuth2Scope                        JSONErrorCode = 50026
+	ErrInvalidWebhookToken                       JSONErrorCode = 50027
+	ErrInvalidRole                               JSONErrorCode = 50028
+	ErrInvalidRecipients                         JSONErrorCode = 50033
+	ErrMessageTooOldToBulkDelete                 JSONErrorCode = 50034
+	ErrInvalidFormBody                           JSONErrorCode = 50035
+	ErrInviteAcceptedToGuildWithoutBot           JSONErrorCode = 50036
+	ErrInvalidActivityAction                     JSONErrorCode = 50039
+	ErrInvalidAPIVersion                         JSONErrorCode = 50041
+	ErrFileUploadedExceedsMaxSize                JSONErrorCode = 50045
+	ErrInvalidFileUploaded                       JSONErrorCode = 50046
+	ErrCannotSelfRedeemGift                      JSONErrorCode = 50054
+	ErrInvalidGuild                              JSONErrorCode = 50055
+	ErrInvalidMessageType                        JSONErrorCode = 50068
+	ErrPaymentSourceRequired                     JSONErrorCode = 50070
+	ErrCannotModifySystemWebhook                 JSONErrorCode = 50073
+	ErrCannotDeleteCommunityRequiredChannel      JSONErrorCode = 50074
+	ErrCannotEditStickersWithinMessage           JSONErrorCode = 50080
+	ErrInvalidStickerSent                        JSONErrorCode = 50081
+	ErrOperationOnArchivedThread                 JSONErrorCode = 50083
+	ErrInvalidThreadNotificationSettings         JSONErrorCode = 50084
+	ErrBeforeValueEarlierThanThreadCreation      JSONErrorCode = 50085
+	ErrCommunityServerChannelsMustBeTextChannels JSONErrorCode = 50086
+	ErrServerNotAvailableInLocation              JSONErrorCode = 50095
+	ErrServerNeedsMonetization                   JSONErrorCode = 50097
+	ErrServerNeedsMoreBoosts                     JSONErrorCode = 50101
+	ErrInvalidJSON                               JSONErrorCode = 50109
+	ErrOwnershipCannotBeTransferredToBot         JSONErrorCode = 50132
+	ErrFailedToResizeAsset                       JSONErrorCode = 50138
+	ErrUploadedFileNotFound                      JSONErrorCode = 50146
+	ErrTwoFactorRequired                         JSONErrorCode = 60003
+	ErrNoUsersWithDiscordTag                     JSONErrorCode = 80004
+	ErrReactionBlocked                           JSONErrorCode = 90001
+	ErrAPIResourceOverloaded                     JSONErrorCode = 130000
+	ErrStageAlreadyOpen                          JSONErrorCode = 150006
+	ErrCannotReplyWithoutReadMessageHistory      JSONErrorCode = 160002
+	ErrThreadAlreadyCreatedForMessage            JSONErrorCode = 160004
+	ErrThreadLocked                              JSONErrorCode = 160005
+	ErrMaxActiveThreads                          JSONErrorCode = 160006
+	ErrMaxActiveAnnouncementThreads              JSONErrorCode = 160007
+	ErrInvalidLottieJSON                         JSONErrorCode = 170001
+	ErrLottieContainsRasterizedImages            JSONErrorCode = 170002
+	ErrStickerMaxFramerateExceeded               JSONErrorCode = 170003
+	ErrStickerMaxFrameCountExceeded              JSONErrorCode = 170004
+	ErrLottieMaxDimensionsExceeded               JSONErrorCode = 170005
+	ErrStickerFrameRateInvalid                   JSONErrorCode = 170006
+	ErrStickerMaxDurationExceeded                JSONErrorCode = 170007
+	ErrCannotUpdateFinishedEvent                 JSONErrorCode = 180000
+	ErrFailedToCreateStageForEvent               JSONErrorCode = 180002
+	ErrMessageBlockedByAutoModeration            JSONErrorCode = 200000
+	ErrTitleBlockedByAutoModeration              JSONErrorCode = 200001
+	ErrWebhooksCanOnlyCreateThreadsInForums      JSONErrorCode = 220003
+)
+
+var jsonErrorCodeMessages = map[JSONErrorCode]string{
+	ErrUnknownAccount:                            "Unknown account",
+	ErrUnknownApplication:                        "Unknown application",
+	ErrUnknownChannel:                            "Unknown channel",
+	ErrUnknownGuild:                              "Unknown guild",
+	ErrUnknownIntegration:                        "Unknown integration",
+	ErrUnknownInvite:                             "Unknown invite",
+	ErrUnknownMember:                             "Unknown member",
+	ErrUnknownMessage:                            "Unknown message",
+	ErrUnknownPermissionOverwrite:                "Unknown permission overwrite",
+	ErrUnknownProvider:                           "Unknown provider",
+	ErrUnknownRole:                               "Unknown role",
+	ErrUnknownToken:                              "Unknown token",
+	ErrUnknownUser:                               "Unknown user",
+	ErrUnknownEmoji:                              "Unknown emoji",
+	ErrUnknownWebhook:                            "Unknown webhook",
+	ErrUnknownWebhookService:                     "Unknown webhook service",
+	ErrUnknownSession:                            "Unknown session",
+	ErrUnknownBan:                                "Unknown ban",
+	ErrUnknownSKU:                                "Unknown SKU",
+	ErrUnknownStoreListing:                       "Unknown store listing",
+	ErrUnknownEntitlement:                        "Unknown entitlement",
+	ErrUnknownBuild:                              "Unknown build",
+	ErrUnknownLobby:                              "Unknown lobby",
+	ErrUnknownBranch:                             "Unknown branch",
+	ErrUnknownStoreDirectoryLayout:               "Unknown store directory layout",
+	ErrUnknownRedistributable:                    "Unknown redistributable",
+	ErrUnknownGiftCode:                           "Unknown gift code",
+	ErrUnknownStream:                             "Unknown stream",
+	ErrUnknownPremiumServerSubscribeCooldown:     "Unknown premium server subscribe cooldown",
+	ErrUnknownGuildTemplate:                      "Unknown guild template",
+	ErrUnknownDiscoverableServerCategory:         "Unknown discoverable server category",
+	ErrUnknownSticker:                            "Unknown sticker",
+	ErrUnknownInteraction:                        "Unknown interaction",
+	ErrUnknownApplicationCommand:                 "Unknown application command",
+	ErrUnknownVoiceState:                         "Unknown voice state",
+	ErrUnknownApplicationCommandPermissions:      "Unknown application command permissions",
+	ErrUnknownStageInstance:                      "Unknown stage instance",
+	ErrUnknownGuildMemberVerificationForm:        "Unknown guild member verification form",
+	ErrUnknownGuildWelcomeScreen:                 "Unknown guild welcome screen",
+	ErrUnknownGuildScheduledEvent:                "Unknown guild scheduled event",
+	ErrUnknownGuildScheduledEventUser:            "Unknown guild scheduled event user",
+	ErrUnknownTag:                                "Unknown tag",
+	ErrBotsCannotUseThisEndpoint:                 "Bots cannot use this endpoint",
+	ErrOnlyBotsCanUseThisEndpoint:                "Only bots can use this endpoint",
+	ErrExplicitContentCannotBeSent:               "Explicit content cannot be sent to the desired recipient(s)",
+	ErrNotAuthorizedForApplication:               "You are not authorized to perform this action on this application",
+	ErrSlowmodeRateLimit:                         "This action cannot be performed due to slowmode rate limit",
+	ErrOnlyOwnerCanPerformAction:                 "Only the owner of this account can perform this action",
+	ErrAnnouncementEditRateLimit:                 "This message cannot be edited due to announcement rate limits",
+	ErrUnderMinimumAge:                           "Under minimum age",
+	ErrChannelWriteRateLimit:                     "The channel you are writing has hit the write rate limit",
+	ErrServerWriteRateLimit:                      "The write action you are performing on the server has hit the write rate limit",
+	ErrWordsNotAllowed:                           "Your stage topic, server name, server description, or channel names contain words that are not allowed",
+	ErrGuildPremiumSubscriptionLevelTooLow:       "Guild premium subscription level too low",
+	ErrMaxGuilds:                                 "Maximum number of guilds reached (100)",
+	ErrMaxFriends:                                "Maximum number of friends reached (1000)",
+	ErrMaxPins:                                   "Maximum number of pins reached for the channel (50)",
+	ErrMaxRecipients:                             "Maximum number of recipients reached (10)",
+	ErrMaxGuildRoles:                             "Maximum number of guild roles reached (250)",
+	ErrMaxWebhooks:                               "Maximum number of webhooks reached (15)",
+	ErrMaxEmojis:                                 "Maximum number of emojis reached",
+	ErrMaxReactions:                              "Maximum number of reactions reached (20)",
+	ErrMaxGroupDMs:                               "Maximum number of group DMs reached (10)",
+	ErrMaxGuildChannels:                          "Maximum number of guild channels reached (500)",
+	ErrMaxAttachments:                            "Maximum number of attachments in a message reached (10)",
+	ErrMaxInvites:                                "Maximum number of invites reached (1000)",
+	ErrMaxAnimatedEmojis:                         "Maximum number of animated emojis reached",
+	ErrMaxServerMembers:                          "Maximum number of server members reached",
+	ErrMaxServerCategories:                       "Maximum number of server categories has been reached (5)",
+	ErrGuildAlreadyHasTemplate:                   "Guild already has a template",
+	ErrMaxApplicationCommands:                    "Maximum number of application commands reached",
+	ErrMaxThreadParticipants:                     "Maximum number of thread participants has been reached (1000)",
+	ErrMaxDailyApplicationCommandCreates:         "Maximum number of daily application command creates has been reached (200)",
+	ErrMaxNonGuildMemberBans:                     "Maximum number of bans for non-guild members have been exceeded",
+	ErrMaxBanFetches:                             "Maximum number of bans fetches has been reached",
+	ErrMaxUncompletedGuildScheduledEvents:        "Maximum number of uncompleted guild scheduled events reached (100)",
+	ErrMaxStickers:                               "Maximum number of stickers reached",
+	ErrMaxPruneRequests:                          "Maximum number of prune requests has been reached. Try again later",
+	ErrMaxGuildWidgetSettingsUpdates:             "Maximum number of guild widget settings updates has been reached. Try again later",
+	ErrMaxEditsToOldMessages:                     "Maximum number of edits to messages older than 1 hour reached. Try again later",
+	ErrMaxPinnedThreadsInForum:                   "Maximum number of pinned threads in a forum channel has been reached",
+	ErrMaxForumTags:                              "Maximum number of tags in a forum channel has been reached",
+	ErrBitrateTooHigh:                            "Bitrate is too high for channel of this type",
+	ErrUnauthorized:                              "Unauthorized. Provide a valid token and try again",
+	ErrAccountVerificationRequired:               "You need to verify your account in order to perform this action",
+	ErrOpeningDMsTooFast:                         "You are opening direct messages too fast",
+	ErrSendMessagesTemporarilyDisabled:           "Send messages has been temporarily disabled",
+	ErrRequestEntityTooLarge:                     "Request entity too large. Try sending something smaller in size",
+	ErrFeatureTemporarilyDisabled:                "This feature has been temporarily disabled server-side",
+	ErrUserBannedFromGuild:                       "The user is banned from this guild",
+	ErrConnectionRevoked:                         "Connection has been revoked",
+	ErrTargetUserNotConnectedToVoice:             "Target user is not connected to voice",
+	ErrMessageAlreadyCrossposted:                 "This message has already been crossposted",
+	ErrApplicationCommandAlreadyExists:           "An application command with that name already exists",
+	ErrApplicationInteractionFailedToSend:        "Application interaction failed to send",
+	ErrCannotSendMessageInForumChannel:           "Cannot send a message in a forum channel",
+	ErrInteractionAlreadyAcknowledged:            "Interaction has already been acknowledged",
+	ErrTagNamesMustBeUnique:                      "Tag names must be unique",
+	ErrMissingAccess:                             "Missing access",
+	ErrInvalidAccountType:                        "Invalid account type",
+	ErrCannotExecuteActionOnDMChannel:            "Cannot execute action on a DM channel",
+	ErrGuildWidgetDisabled:                       "Guild widget disabled",
+	ErrCannotEditMessageByOtherUser:              "Cannot edit a message authored by another user",
+	ErrCannotSendEmptyMessage:                    "Cannot send an empty message",
+	ErrCannotSendMessagesToUser:                  "Cannot send messages to this user",
+	ErrCannotSendMessagesInNonTextChannel:        "Cannot send messages in a non-text channel",
+	ErrChannelVerificationLevelTooHigh:           "Channel verification level is too high for you to gain access",
+	ErrOAuth2ApplicationHasNoBot:                 "OAuth2 application does not have a bot",
+	ErrOAuth2ApplicationLimitReached:             "OAuth2 application limit reached",
+	ErrInvalidOAuth2State:                        "Invalid OAuth2 state",
+	ErrMissingPermissions:                        "You lack permissions to perform that action",
+	ErrInvalidAuthenticationToken:                "Invalid authentication token provided",
+	ErrNoteTooLong:                               "Note was too long",
+	ErrInvalidBulkDeleteCount:                    "Provided too few or too many messages to delete. Must provide at least 2 and fewer than 100 messages to delete",
+	ErrInvalidMFALevel:                           "Invalid MFA level",
+	ErrCannotPinMessageInOtherChannel:            "A message can only be pinned to the channel it was sent in",
+	ErrInvalidInviteCode:                         "Invite code was either invalid or taken",
+	ErrCannotExecuteActionOnSystemMessage:        "Cannot execute action on a system message",
+	ErrCannotExecuteActionOnChannelType:          "Cannot execute action on this channel type",
+	ErrInvalidOAuth2AccessToken:                  "Invalid OAuth2 access token provided",
+	ErrMissingOAuth2Scope:                        "Missing required OAuth2 scope",
+	ErrInvalidWebhookToken:                       "Invalid webhook token provided",
+	ErrInvalidRole:                               "Invalid role",
+	ErrInvalidRecipients:                         "Invalid recipient(s)",
+	ErrMessageTooOldToBulkDelete:                 "A message provided was too old to bulk delete",
+	ErrInvalidFormBody:                           "Invalid form body or invalid Content-Type provided",
+	ErrInviteAcceptedToGuildWithoutBot:           "An invite was accepted to a guild the application's bot is not in",
+	ErrInvalidActivityAction:                     "Invalid activity action",
+	ErrInvalidAPIVersion:                         "Invalid API version provided",
+	ErrFileUploadedExceedsMaxSize:                "File uploaded exceeds the maximum size",
+	ErrInvalidFileUploaded:                       "Invalid file uploaded",
+	ErrCannotSelfRedeemGift:                      "Cannot self-redeem this gift",
+	ErrInvalidGuild:                              "Invalid guild",
+	ErrInvalidMessageType:                        "Invalid message type",
+	ErrPaymentSourceRequired:                     "Payment source required to redeem gift",
+	ErrCannotModifySystemWebhook:                 "Cannot modify a system webhook",
+	ErrCannotDeleteCommunityRequiredChannel:      "Cannot delete a channel required for community guilds",
+	ErrCannotEditStickersWithinMessage:           "Cannot edit stickers within a message",
+	ErrInvalidStickerSent:                        "Invalid sticker sent",
+	ErrOperationOnArchivedThread:                 "Tried to perform an operation on an archived thread",
+	ErrInvalidThreadNotificationSettings:         "Invalid thread notification settings",
+	ErrBeforeValueEarlierThanThreadCreation:      "before value is earlier than the thread creation date",
+	ErrCommunityServerChannelsMustBeTextChannels: "Community server channels must be text channels",
+	ErrServerNotAvailableInLocation:              "This server is not available in your location",
+	ErrServerNeedsMonetization:                   "This server needs monetization enabled in order to perform this action",
+	ErrServerNeedsMoreBoosts:                     "This server needs more boosts to perform this action",
+	ErrInvalidJSON:                               "The request body contains invalid JSON",
+	ErrOwnershipCannotBeTransferredToBot:         "Ownership cannot be transferred to a bot user",
+	ErrFailedToResizeAsset:                       "Failed to resize asset below the maximum size",
+	ErrUploadedFileNotFound:                      "Uploaded file not found",
+	ErrTwoFactorRequired:                         "Two factor is required for this operation",
+	ErrNoUsersWithDiscordTag:                     "No users with DiscordTag exist",
+	ErrReactionBlocked:                           "Reaction was blocked",
+	ErrAPIResourceOverloaded:                     "API resource is currently overloaded. Try again a little later",
+	ErrStageAlreadyOpen:                          "The stage is already open",
+	ErrCannotReplyWithoutReadMessageHistory:      "Cannot reply without permission to read message history",
+	ErrThreadAlreadyCreatedForMessage:            "A thread has already been created for this message",
+	ErrThreadLocked:                              "Thread is locked",
+	ErrMaxActiveThreads:                          "Maximum number of active threads reached",
+	ErrMaxActiveAnnouncementThreads:              "Maximum number of active announcement threads reached",
+	ErrInvalidLottieJSON:                         "Invalid JSON for uploaded Lottie file",
+	ErrLottieContainsRasterizedImages:            "Uploaded Lotties cannot contain rasterized images such as PNG or JPEG",
+	ErrStickerMaxFramerateExceeded:               "Sticker maximum framerate exceeded",
+	ErrStickerMaxFrameCountExceeded:              "Sticker frame count exceeds maximum of 1000 frames",
+	ErrLottieMaxDimensionsExceeded:               "Lottie animation maximum dimensions exceeded",
+	ErrStickerFrameRateInvalid:                   "Sticker frame rate is either too small or too large",
+	ErrStickerMaxDurationExceeded:                "Sticker animation duration exceeds maximum of 5 seconds",
+	ErrCannotUpdateFinishedEvent:                 "Cannot update a finished event",
+	ErrFailedToCreateStageForEvent:               "Failed to create stage needed for stage event",
+	ErrMessageBlockedByAutoModeration:            "Message was blocked by automatic moderation",
+	ErrTitleBlockedByAutoModeration:              "Title was blocked by automatic moderation",
+	ErrWebhooksCanOnlyCreateThreadsInForums:      "Webhooks can only create threads in forum channels",
+}
@@ -0,0 +1,39 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewError(t *testing.T) {
+	rs := &http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request"}
+	err := NewError(nil, nil, rs, []byte(`{
+		"code": 50035,
+		"message": "Invalid Form Body",
+		"errors": {
+			"embeds": {"0": {"title": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "Must be 256 or fewer in length."}]}}},
+			"content": {"_errors": [{"code": "BASE_TYPE_REQUIRED", "message": "This field is required"}]}
+		}
+	}`))
+
+	var rErr *Error
+	assert.True(t, errors.As(err, &rErr))
+	assert.Equal(t, ErrInvalidFormBody, rErr.Code)
+	assert.Equal(t, "Invalid Form Body", rErr.Message)
+	assert.Equal(t, []FieldError{
+		{Path: "content", Code: "BASE_TYPE_REQUIRED", Message: "This field is required"},
+		{Path: "embeds.0.title", Code: "BASE_TYPE_MAX_LENGTH", Message: "Must be 256 or fewer in length."},
+	}, rErr.Errors)
+
+	assert.ErrorIs(t, err, ErrInvalidFormBody)
+	assert.NotErrorIs(t, err, ErrUnknownMessage)
+	assert.ErrorIs(t, err, &Error{Response: &http.Response{StatusCode: http.StatusBadRequest}})
+	assert.Equal(t, "Status: 400 Bad Request, Code: 50035, Message: Invalid Form Body, Errors: content: This field is required (BASE_TYPE_REQUIRED), embeds.0.title: Must be 256 or fewer in length. (BASE_TYPE_MAX_LENGTH)", err.Error())
+
+	err = NewError(nil, nil, rs, []byte(`not json`))
+	assert.NotErrorIs(t, err, ErrUnknownMessage)
+	assert.Equal(t, "Status: 400 Bad Request, Body: not json", err.Error())
+}
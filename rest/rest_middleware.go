@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"net/http"
+)
+
+// Request is the request passed through the Middleware(s) of a Client.
+type Request struct {
+	// Endpoint is the CompiledEndpoint the request is sent to.
+	Endpoint *CompiledEndpoint
+	// Body is the marshalled request body or nil if the request has none.
+	Body []byte
+	// Request is the http.Request which will be sent. Middleware(s) can modify it, for example to add headers.
+	Request *http.Request
+	// Attempt is the number of the attempt starting at 1. It increases with every retry.
+	Attempt int
+}
+
+// RoundTripFunc sends a Request and returns either the http.Response or an error.
+// The http.Response body is read & closed by the Client after all Middleware(s) returned.
+type RoundTripFunc func(rq *Request) (*http.Response, error)
+
+// Middleware wraps the round trip of every request the Client sends, including retries.
+// Middleware(s) can observe or modify the request & response or return a response without calling next, for example in tests.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// chainMiddlewares wraps the given RoundTripFunc with the Middleware(s). The first Middleware is the outermost one.
+func chainMiddlewares(roundTrip RoundTripFunc, middlewares []Middleware) RoundTripFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		roundTrip = middlewares[i](roundTrip)
+	}
+	return roundTrip
+}
@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultGlobalRateLimit is the number of requests per second Discord allows per bot token.
+const DefaultGlobalRateLimit = 50
+
+// unknownBucketTimeout is how long a bucket which limits are not known yet is locked after the first request was reserved.
+// This makes sure only one request is sent until Discord tells us the limits, unless that request never returns.
+const unknownBucketTimeout = 5 * time.Second
+
+// RateLimitBucket is the rate limit state Discord returned for a bucket.
+// Durations are used instead of timestamps, so the clocks of the processes sharing a RateLimitStore don't matter.
+type RateLimitBucket struct {
+	// Limit is the number of requests which can be made in a reset window, -1 if unknown
+	Limit int `json:"limit"`
+	// Remaining is the number of requests left in the current reset window, -1 if unknown
+	Remaining int `json:"remaining"`
+	// ResetAfter is the duration after which the bucket resets
+	ResetAfter time.Duration `json:"reset_after"`
+}
+
+// RateLimitStore is the shared state of a RateLimiter created with NewDistributedRateLimiter.
+// All methods need to be atomic as they are called concurrently by multiple processes.
+type RateLimitStore interface {
+	// RouteBucket returns the Discord bucket hash of the given route or an empty string if it is not known yet.
+	RouteBucket(route string) (string, error)
+
+	// SetRouteBucket sets the Discord bucket hash of the given route.
+	SetRouteBucket(route string, bucket string) error
+
+	// Reserve reserves one request in the given bucket & the global rate limit of globalLimit requests per second.
+	// It returns 0 if the request was reserved or the duration to wait before trying again.
+	Reserve(bucket string, globalLimit int) (time.Duration, error)
+
+	// Update updates the given bucket with the rate limit state Discord returned.
+	Update(bucket string, state RateLimitBucket) error
+
+	// Release gives back a request reserved with Reserve which got no response with rate limit headers, for example because it failed.
+	Release(bucket string) error
+
+	// SetGlobalReset blocks all requests for the given duration.
+	SetGlobalReset(retryAfter time.Duration) error
+}
+
+// NewMemoryRateLimitStore returns a new in memory RateLimitStore.
+// It can be shared by multiple RateLimiter(s) in one process or served to other processes with NewRateLimitStoreHandler.
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		routes:  map[string]string{},
+		buckets: map[string]*memoryRateLimitBucket{},
+	}
+}
+
+type memoryRateLimitBucket struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+type memoryRateLimitStore struct {
+	mu      sync.Mutex
+	routes  map[string]string
+	buckets map[string]*memoryRateLimitBucket
+
+	globalReset       time.Time
+	globalWindowStart time.Time
+	globalCount       int
+}
+
+func (s *memoryRateLimitStore) RouteBucket(route string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.routes[route], nil
+}
+
+func (s *memoryRateLimitStore) SetRouteBucket(route string, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[route] = bucket
+	return nil
+}
+
+func (s *memoryRateLimitStore) Reserve(bucket string, globalLimit int) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+
+	if s.globalReset.After(now) {
+		return s.globalReset.Sub(now), nil
+	}
+	if now.Sub(s.globalWindowStart) >= time.Second {
+		s.globalWindowStart = now
+		s.globalCount = 0
+	}
+	if globalLimit > 0 && s.globalCount >= globalLimit {
+		return s.globalWindowStart.Add(time.Second).Sub(now), nil
+	}
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		// we don't know the limits yet, only let one request through until they are known
+		s.buckets[bucket] = &memoryRateLimitBucket{
+			limit:     -1,
+			remaining: 0,
+			reset:     now.Add(unknownBucketTimeout),
+		}
+		s.globalCount++
+		return 0, nil
+	}
+
+	if !b.reset.After(now) {
+		b.remaining = b.limit
+		if b.remaining < 1 {
+			b.remaining = 1
+		}
+		b.reset = now.Add(unknownBucketTimeout)
+	}
+	if b.remaining == 0 {
+		return b.reset.Sub(now), nil
+	}
+	b.remaining--
+	s.globalCount++
+	return 0, nil
+}
+
+func (s *memoryRateLimitStore) Update(bucket string, state RateLimitBucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	reset := now.Add(state.ResetAfter)
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		b = &memoryRateLimitBucket{limit: -1}
+		s.buckets[bucket] = b
+	}
+	known := b.limit != -1
+
+	if state.Limit != -1 {
+		b.limit = state.Limit
+	}
+	// other processes might have reserved requests which discord did not count yet, so only lower the remaining requests in the same window
+	if state.Remaining != -1 && (!known || !b.reset.After(now) || reset.Sub(b.reset) > time.Second || state.Remaining < b.remaining) {
+		b.remaining = state.Remaining
+	}
+	b.reset = reset
+
+	// clean up buckets which reset a while ago
+	for hash, rb := range s.buckets {
+		if now.Sub(rb.reset) > time.Minute {
+			delete(s.buckets, hash)
+		}
+	}
+	return nil
+}
+
+func (s *memoryRateLimitStore) Release(bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		return nil
+	}
+	if b.limit == -1 {
+		// the limits are still unknown, let the next request through instead of waiting for the unknown bucket timeout
+		b.reset = time.Now()
+		return nil
+	}
+	if b.remaining < b.limit {
+		b.remaining++
+	}
+	return nil
+}
+
+func (s *memoryRateLimitStore) SetGlobalReset(retryAfter time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.globalReset = time.Now().Add(retryAfter)
+	return nil
+}
@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/json"
+)
+
+type rateLimitStoreRequest struct {
+	Route       string          `json:"route,omitempty"`
+	Bucket      string          `json:"bucket,omitempty"`
+	GlobalLimit int             `json:"global_limit,omitempty"`
+	State       RateLimitBucket `json:"state"`
+	RetryAfter  time.Duration   `json:"retry_after,omitempty"`
+}
+
+type rateLimitStoreResponse struct {
+	Bucket string        `json:"bucket,omitempty"`
+	Wait   time.Duration `json:"wait,omitempty"`
+}
+
+// NewRateLimitStoreHandler returns a http.Handler which serves the given RateLimitStore to other processes using NewHTTPRateLimitStore.
+// This is a simple coordinator for multiple processes sharing one bot token. Make sure it is only reachable by your processes.
+func NewRateLimitStoreHandler(store RateLimitStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var rq rateLimitStoreRequest
+		if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var (
+			rs  rateLimitStoreResponse
+			err error
+		)
+		switch strings.TrimPrefix(r.URL.Path, "/") {
+		case "route-bucket":
+			rs.Bucket, err = store.RouteBucket(rq.Route)
+		case "set-route-bucket":
+			err = store.SetRouteBucket(rq.Route, rq.Bucket)
+		case "reserve":
+			rs.Wait, err = store.Reserve(rq.Bucket, rq.GlobalLimit)
+		case "update":
+			err = store.Update(rq.Bucket, rq.State)
+		case "release":
+			err = store.Release(rq.Bucket)
+		case "set-global-reset":
+			err = store.SetGlobalReset(rq.RetryAfter)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(rs)
+	})
+}
+
+// NewHTTPRateLimitStore returns a RateLimitStore which uses a RateLimitStore served with NewRateLimitStoreHandler at the given url.
+// If httpClient is nil, http.DefaultClient is used.
+func NewHTTPRateLimitStore(url string, httpClient *http.Client) RateLimitStore {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &httpRateLimitStore{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: httpClient,
+	}
+}
+
+type httpRateLimitStore struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (s *httpRateLimitStore) do(path string, rq rateLimitStoreRequest) (rateLimitStoreResponse, error) {
+	var rs rateLimitStoreResponse
+	body, err := json.Marshal(rq)
+	if err != nil {
+		return rs, err
+	}
+
+	httpRs, err := s.httpClient.Post(s.url+"/"+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return rs, fmt.Errorf("error doing rate limit store request: %w", err)
+	}
+	defer httpRs.Body.Close()
+
+	if httpRs.StatusCode != http.StatusOK {
+		rsBody, _ := io.ReadAll(httpRs.Body)
+		return rs, fmt.Errorf("rate limit store returned status %s: %s", httpRs.Status, strings.TrimSpace(string(rsBody)))
+	}
+	err = json.NewDecoder(httpRs.Body).Decode(&rs)
+	return rs, err
+}
+
+func (s *httpRateLimitStore) RouteBucket(route string) (string, error) {
+	rs, err := s.do("route-bucket", rateLimitStoreRequest{Route: route})
+	return rs.Bucket, err
+}
+
+func (s *httpRateLimitStore) SetRouteBucket(route string, bucket string) error {
+	_, err := s.do("set-route-bucket", rateLimitStoreRequest{Route: route, Bucket: bucket})
+	return err
+}
+
+func (s *httpRateLimitStore) Reserve(bucket string, globalLimit int) (time.Duration, error) {
+	rs, err := s.do("reserve", rateLimitStoreRequest{Bucket: bucket, GlobalLimit: globalLimit})
+	return rs.Wait, err
+}
+
+func (s *httpRateLimitStore) Update(bucket string, state RateLimitBucket) error {
+	_, err := s.do("update", rateLimitStoreRequest{Bucket: bucket, State: state})
+	return err
+}
+
+func (s *httpRateLimitStore) Release(bucket string) error {
+	_, err := s.do("release", rateLimitStoreRequest{Bucket: bucket})
+	return err
+}
+
+func (s *httpRateLimitStore) SetGlobalReset(retryAfter time.Duration) error {
+	_, err := s.do("set-global-reset", rateLimitStoreRequest{RetryAfter: retryAfter})
+	return err
+}
@@ -2,15 +2,18 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"sync"
 	"time"
-
-	"github.com/sasha-s/go-csync"
 )
 
+// ErrRateLimitQueueFull is returned by RateLimiter.WaitBucket when too many requests are already waiting for the same bucket. See WithMaxBucketQueueSize.
+var ErrRateLimitQueueFull = errors.New("rate limit bucket queue is full")
+
 // RateLimiter can be used to supply your own rate limit implementation
 type RateLimiter interface {
 	// MaxRetries returns the maximum number of retries the client should do
@@ -52,6 +55,9 @@ type (
 
 		// global Rate Limit
 		global time.Time
+		// proactive global rate limit
+		globalMu       priorityMutex
+		globalRequests []time.Time
 
 		// APIRoute -> Hash
 		hashes   map[*Endpoint]string
@@ -99,7 +105,8 @@ func (l *rateLimiterImpl) Close(ctx context.Context) {
 		wg.Add(1)
 		b := l.buckets[i]
 		go func() {
-			_ = b.mu.CLock(ctx)
+			// wait for all pending requests first
+			_ = b.mu.Lock(ctx, Priority(math.MinInt))
 			wg.Done()
 		}()
 	}
@@ -110,6 +117,8 @@ func (l *rateLimiterImpl) Reset() {
 	l.buckets = map[string]*bucket{}
 	l.bucketsMu = sync.Mutex{}
 	l.global = time.Time{}
+	l.globalMu = priorityMutex{}
+	l.globalRequests = nil
 	l.hashes = map[*Endpoint]string{}
 	l.hashesMu = sync.Mutex{}
 }
@@ -155,9 +164,15 @@ func (l *rateLimiterImpl) getBucket(endpoint *CompiledEndpoint, create bool) *bu
 }
 
 func (l *rateLimiterImpl) WaitBucket(ctx context.Context, endpoint *CompiledEndpoint) error {
+	start := time.Now()
 	b := l.getBucket(endpoint, true)
-	l.config.Logger.Tracef("locking rest bucket, ID: %s, Limit: %d, Remaining: %d, Reset: %s", b.ID, b.Limit, b.Remaining, b.Reset)
-	if err := b.mu.CLock(ctx); err != nil {
+	priority := requestPriority(ctx, endpoint)
+	if l.config.MaxBucketQueueSize > 0 && b.mu.Waiting() >= l.config.MaxBucketQueueSize {
+		return ErrRateLimitQueueFull
+	}
+
+	l.config.Logger.Tracef("locking rest bucket, ID: %s, Limit: %d, Remaining: %d, Reset: %s, Priority: %d", b.ID, b.Limit, b.Remaining, b.Reset, priority)
+	if err := b.mu.Lock(ctx, priority); err != nil {
 		return err
 	}
 
@@ -173,6 +188,7 @@ func (l *rateLimiterImpl) WaitBucket(ctx context.Context, endpoint *CompiledEndp
 	if until.After(now) {
 		// TODO: do we want to return early when we know the rate limit bigger than ctx deadline?
 		if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
+			b.mu.Unlock()
 			return context.DeadlineExceeded
 		}
 
@@ -183,9 +199,54 @@ func (l *rateLimiterImpl) WaitBucket(ctx context.Context, endpoint *CompiledEndp
 		case <-time.After(until.Sub(now)):
 		}
 	}
+
+	// interaction endpoints are not bound to the global rate limit
+	if l.config.GlobalRateLimit > 0 && !isInteractionEndpoint(endpoint.Endpoint) {
+		if err := l.waitGlobal(ctx, priority); err != nil {
+			b.mu.Unlock()
+			return err
+		}
+	}
+	// the Discord bucket hash is only known after the first response
+	bucketID := b.ID
+	if bucketID == "" {
+		bucketID = routeHash(endpoint)
+	}
+	l.config.Metrics.RateLimitWait(bucketID, time.Since(start))
 	return nil
 }
 
+// waitGlobal waits until a request can be made without exceeding the global rate limit & reserves it.
+func (l *rateLimiterImpl) waitGlobal(ctx context.Context, priority Priority) error {
+	if err := l.globalMu.Lock(ctx, priority); err != nil {
+		return err
+	}
+	defer l.globalMu.Unlock()
+
+	for {
+		now := time.Now()
+		// drop requests older than one second
+		i := 0
+		for i < len(l.globalRequests) && now.Sub(l.globalRequests[i]) >= time.Second {
+			i++
+		}
+		l.globalRequests = l.globalRequests[i:]
+
+		if len(l.globalRequests) < l.config.GlobalRateLimit {
+			l.globalRequests = append(l.globalRequests, now)
+			return nil
+		}
+
+		wait := l.globalRequests[0].Add(time.Second).Sub(now)
+		l.config.Logger.Tracef("global rate limit reached, waiting %s", wait)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
 func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Response) error {
 	b := l.getBucket(endpoint, false)
 	if b == nil {
@@ -200,86 +261,130 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 	if rs == nil || rs.Header == nil {
 		return nil
 	}
-	bucketHeader := rs.Header.Get("X-RateLimit-Bucket")
+	rateLimit, err := parseRateLimit(rs)
+	if err != nil {
+		return err
+	}
 
 	// if we don't have a bucket header, we can't update anything
-	if bucketHeader == "" {
+	if rateLimit.bucket == "" {
 		return nil
 	}
 
-	b.ID = bucketHeader
+	b.ID = rateLimit.bucket
+	l.config.Logger.Tracef("code: %d, bucket: %s, global: %t, cloudflare: %t, limit: %d, remaining: %d, reset: %s, retryAfter: %s", rs.StatusCode, rateLimit.bucket, rateLimit.global, rateLimit.cloudflare, rateLimit.limit, rateLimit.remaining, rateLimit.reset, rateLimit.retryAfter)
 
-	global := rs.Header.Get("X-RateLimit-Global") != ""
-	cloudflare := rs.Header.Get("via") == ""
+	// we hit a rate limit. let's see if it was global cloudflare or a route specific one
+	if rs.StatusCode == http.StatusTooManyRequests {
+		reset := time.Now().Add(rateLimit.retryAfter)
+		if rateLimit.global {
+			l.global = reset
+			l.config.Logger.Warnf("global rate limit exceeded, retry after: %s", rateLimit.retryAfter)
+		} else if rateLimit.cloudflare {
+			l.global = reset
+			l.config.Logger.Warnf("cloudflare rate limit exceeded, retry after: %s", rateLimit.retryAfter)
+		} else {
+			b.Remaining = 0
+			b.Reset = reset
+			l.config.Logger.Warnf("rate limit on route %s exceeded, retry after: %s", endpoint.URL, rateLimit.retryAfter)
+		}
+		return nil
+	}
+
+	if rateLimit.limit != -1 {
+		b.Limit = rateLimit.limit
+	}
+	if rateLimit.remaining != -1 {
+		b.Remaining = rateLimit.remaining
+	}
+	b.Reset = rateLimit.reset
+	return nil
+}
+
+// rateLimit holds the parsed rate limit headers of a response
+type rateLimit struct {
+	bucket     string
+	global     bool
+	cloudflare bool
+	// limit & remaining are -1 if the header was not present
+	limit      int
+	remaining  int
+	reset      time.Time
+	resetAfter time.Duration
+	retryAfter time.Duration
+}
+
+// parseRateLimit parses the rate limit headers of the given http.Response.
+// If the response has no bucket header, only the bucket field is set.
+func parseRateLimit(rs *http.Response) (rateLimit, error) {
+	rateLimit := rateLimit{
+		bucket:    rs.Header.Get("X-RateLimit-Bucket"),
+		limit:     -1,
+		remaining: -1,
+	}
+	if rateLimit.bucket == "" {
+		return rateLimit, nil
+	}
+
+	rateLimit.global = rs.Header.Get("X-RateLimit-Global") != ""
+	rateLimit.cloudflare = rs.Header.Get("via") == ""
 	remainingHeader := rs.Header.Get("X-RateLimit-Remaining")
 	limitHeader := rs.Header.Get("X-RateLimit-Limit")
 	resetHeader := rs.Header.Get("X-RateLimit-Reset")
 	resetAfterHeader := rs.Header.Get("X-RateLimit-Reset-After")
 	retryAfterHeader := rs.Header.Get("Retry-After")
 
-	l.config.Logger.Tracef("code: %d, headers: global %t, cloudflare: %t, remaining: %s, limit: %s, reset: %s, retryAfter: %s", rs.StatusCode, global, cloudflare, remainingHeader, limitHeader, resetHeader, retryAfterHeader)
-
-	// we hit a rate limit. let's see if it was global cloudflare or a route specific one
 	if rs.StatusCode == http.StatusTooManyRequests {
 		retryAfter, err := strconv.Atoi(retryAfterHeader)
 		if err != nil {
-			return fmt.Errorf("invalid retryAfter %s: %w", retryAfterHeader, err)
-		}
-		reset := time.Now().Add(time.Second * time.Duration(retryAfter))
-		if global {
-			l.global = reset
-			l.config.Logger.Warnf("global rate limit exceeded, retry after: %ds", retryAfter)
-		} else if cloudflare {
-			l.global = reset
-			l.config.Logger.Warnf("cloudflare rate limit exceeded, retry after: %ds", retryAfter)
-		} else {
-			b.Remaining = 0
-			b.Reset = reset
-			l.config.Logger.Warnf("rate limit on route %s exceeded, retry after: %ds", endpoint.URL, retryAfter)
+			return rateLimit, fmt.Errorf("invalid retryAfter %s: %w", retryAfterHeader, err)
 		}
-		return nil
+		rateLimit.retryAfter = time.Second * time.Duration(retryAfter)
+		return rateLimit, nil
 	}
 
 	if limitHeader != "" {
 		limit, err := strconv.Atoi(limitHeader)
 		if err != nil {
-			return fmt.Errorf("invalid limit %s: %s", limitHeader, err)
+			return rateLimit, fmt.Errorf("invalid limit %s: %s", limitHeader, err)
 		}
-		b.Limit = limit
+		rateLimit.limit = limit
 	}
 
 	if remainingHeader != "" {
 		remaining, err := strconv.Atoi(remainingHeader)
 		if err != nil {
-			return fmt.Errorf("invalid remaining %s: %s", remainingHeader, err)
+			return rateLimit, fmt.Errorf("invalid remaining %s: %s", remainingHeader, err)
 		}
-		b.Remaining = remaining
+		rateLimit.remaining = remaining
 	}
 
 	// we prioritize the reset after header over the reset header as it's more accurate due to clock differences
 	if resetAfterHeader != "" {
 		resetAfter, err := strconv.ParseFloat(resetAfterHeader, 64)
 		if err != nil {
-			return fmt.Errorf("invalid reset after %s: %s", resetAfterHeader, err)
+			return rateLimit, fmt.Errorf("invalid reset after %s: %s", resetAfterHeader, err)
 		}
 
-		b.Reset = time.Now().Add(time.Duration(resetAfter) * time.Second)
+		rateLimit.resetAfter = time.Duration(resetAfter * float64(time.Second))
+		rateLimit.reset = time.Now().Add(rateLimit.resetAfter)
 	} else if resetHeader != "" {
 		reset, err := strconv.ParseFloat(resetHeader, 64)
 		if err != nil {
-			return fmt.Errorf("invalid reset %s: %s", resetHeader, err)
+			return rateLimit, fmt.Errorf("invalid reset %s: %s", resetHeader, err)
 		}
 
 		sec := int64(reset)
-		b.Reset = time.Unix(sec, int64((reset-float64(sec))*float64(time.Second)))
+		rateLimit.reset = time.Unix(sec, int64((reset-float64(sec))*float64(time.Second)))
+		rateLimit.resetAfter = time.Until(rateLimit.reset)
 	} else {
-		return fmt.Errorf("no reset or reset after header found in response")
+		return rateLimit, fmt.Errorf("no reset or reset after header found in response")
 	}
-	return nil
+	return rateLimit, nil
 }
 
 type bucket struct {
-	mu        csync.Mutex
+	mu        priorityMutex
 	ID        string
 	Reset     time.Time
 	Remaining int
@@ -3,6 +3,7 @@ package rest
 import (
 	"time"
 
+	"github.com/disgoorg/disgo/metrics"
 	"github.com/disgoorg/log"
 )
 
@@ -12,14 +13,19 @@ func DefaultRateLimiterConfig() *RateLimiterConfig {
 		Logger:          log.Default(),
 		MaxRetries:      10,
 		CleanupInterval: time.Second * 10,
+		GlobalRateLimit: DefaultGlobalRateLimit,
+		Metrics:         metrics.Noop,
 	}
 }
 
 // RateLimiterConfig is the configuration for the rate limiter.
 type RateLimiterConfig struct {
-	Logger          log.Logger
-	MaxRetries      int
-	CleanupInterval time.Duration
+	Logger             log.Logger
+	MaxRetries         int
+	CleanupInterval    time.Duration
+	GlobalRateLimit    int
+	MaxBucketQueueSize int
+	Metrics            metrics.Metrics
 }
 
 // RateLimiterConfigOpt can be used to supply optional parameters to NewRateLimiter.
@@ -52,3 +58,26 @@ func WithCleanupInterval(cleanupInterval time.Duration) RateLimiterConfigOpt {
 		config.CleanupInterval = cleanupInterval
 	}
 }
+
+// WithGlobalRateLimit sets the number of requests per second which can be made with one bot token.
+// The RateLimiter waits before exceeding it instead of only after Discord returned a 429. 0 disables this.
+func WithGlobalRateLimit(globalRateLimit int) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.GlobalRateLimit = globalRateLimit
+	}
+}
+
+// WithMaxBucketQueueSize sets how many requests can wait for the same rate limit bucket.
+// Further requests fail with ErrRateLimitQueueFull instead of waiting. 0 means no limit, which is the default.
+func WithMaxBucketQueueSize(maxBucketQueueSize int) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.MaxBucketQueueSize = maxBucketQueueSize
+	}
+}
+
+// WithRateLimiterMetrics sets the metrics.Metrics which records how long requests waited for their rate limit bucket.
+func WithRateLimiterMetrics(m metrics.Metrics) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.Metrics = m
+	}
+}
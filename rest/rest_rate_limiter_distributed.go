@@ -0,0 +1,153 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NewDistributedRateLimiter returns a new RateLimiter which shares its bucket hashes, buckets & the global rate limit with other processes through the given RateLimitStore.
+// Use this when multiple processes share one bot token. See NewMemoryRateLimitStore & NewHTTPRateLimitStore.
+func NewDistributedRateLimiter(store RateLimitStore, opts ...RateLimiterConfigOpt) RateLimiter {
+	config := DefaultRateLimiterConfig()
+	config.Apply(opts)
+
+	return &distributedRateLimiterImpl{
+		config: *config,
+		store:  store,
+	}
+}
+
+type distributedRateLimiterImpl struct {
+	config RateLimiterConfig
+	store  RateLimitStore
+
+	// route -> Discord bucket hash, cached so we don't need to ask the store on every request
+	routeBuckets sync.Map
+}
+
+func (l *distributedRateLimiterImpl) MaxRetries() int {
+	return l.config.MaxRetries
+}
+
+func (l *distributedRateLimiterImpl) Close(_ context.Context) {}
+
+func (l *distributedRateLimiterImpl) Reset() {
+	l.routeBuckets = sync.Map{}
+}
+
+func routeHash(endpoint *CompiledEndpoint) string {
+	return endpoint.Endpoint.Method + "+" + endpoint.Endpoint.Route
+}
+
+// bucketHash returns the Discord bucket hash of the given endpoint if known & the route otherwise.
+func (l *distributedRateLimiterImpl) bucketHash(endpoint *CompiledEndpoint) (string, error) {
+	route := routeHash(endpoint)
+	if v, ok := l.routeBuckets.Load(route); ok {
+		return v.(string), nil
+	}
+	hash, err := l.store.RouteBucket(route)
+	if err != nil {
+		return "", err
+	}
+	if hash == "" {
+		return route, nil
+	}
+	l.routeBuckets.Store(route, hash)
+	return hash, nil
+}
+
+// bucketKey returns the key of the bucket of the given endpoint in the RateLimitStore. This is the bucketHash combined with the major parameters.
+func bucketKey(hash string, endpoint *CompiledEndpoint) string {
+	if endpoint.MajorParams != "" {
+		return hash + "+" + endpoint.MajorParams
+	}
+	return hash
+}
+
+func (l *distributedRateLimiterImpl) WaitBucket(ctx context.Context, endpoint *CompiledEndpoint) error {
+	hash, err := l.bucketHash(endpoint)
+	if err != nil {
+		return err
+	}
+	bucket := bucketKey(hash, endpoint)
+
+	start := time.Now()
+	for {
+		wait, err := l.store.Reserve(bucket, l.config.GlobalRateLimit)
+		if err != nil {
+			return err
+		}
+		if wait <= 0 {
+			l.config.Metrics.RateLimitWait(hash, time.Since(start))
+			return nil
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return context.DeadlineExceeded
+		}
+		l.config.Logger.Tracef("waiting %s for rate limit bucket %s", wait, bucket)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// release gives back the request reserved by WaitBucket, so the bucket isn't blocked until the unknown bucket timeout.
+func (l *distributedRateLimiterImpl) release(endpoint *CompiledEndpoint) error {
+	hash, err := l.bucketHash(endpoint)
+	if err != nil {
+		return err
+	}
+	return l.store.Release(bucketKey(hash, endpoint))
+}
+
+func (l *distributedRateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Response) error {
+	// no response provided means we can't update anything and just give back the reserved request
+	if rs == nil || rs.Header == nil {
+		return l.release(endpoint)
+	}
+
+	rateLimit, err := parseRateLimit(rs)
+	if err != nil {
+		return err
+	}
+	// if we don't have a bucket header, we can't update anything either
+	if rateLimit.bucket == "" {
+		return l.release(endpoint)
+	}
+
+	route := routeHash(endpoint)
+	if v, ok := l.routeBuckets.Load(route); !ok || v.(string) != rateLimit.bucket {
+		if err = l.store.SetRouteBucket(route, rateLimit.bucket); err != nil {
+			return err
+		}
+		l.routeBuckets.Store(route, rateLimit.bucket)
+	}
+	bucket := bucketKey(rateLimit.bucket, endpoint)
+
+	if rs.StatusCode == http.StatusTooManyRequests {
+		if rateLimit.global || rateLimit.cloudflare {
+			l.config.Logger.Warnf("global rate limit exceeded, retry after: %s", rateLimit.retryAfter)
+			return l.store.SetGlobalReset(rateLimit.retryAfter)
+		}
+		l.config.Logger.Warnf("rate limit on route %s exceeded, retry after: %s", endpoint.URL, rateLimit.retryAfter)
+		return l.store.Update(bucket, RateLimitBucket{
+			Limit:      -1,
+			Remaining:  0,
+			ResetAfter: rateLimit.retryAfter,
+		})
+	}
+
+	return l.store.Update(bucket, RateLimitBucket{
+		Limit:      rateLimit.limit,
+		Remaining:  rateLimit.remaining,
+		ResetAfter: rateLimit.resetAfter,
+	})
+}
@@ -0,0 +1,119 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	store := NewMemoryRateLimitStore()
+
+	// first request to an unknown bucket is let through, following ones wait for the limits
+	wait, err := store.Reserve("bucket", 0)
+	assert.NoError(t, err)
+	assert.Zero(t, wait)
+	wait, err = store.Reserve("bucket", 0)
+	assert.NoError(t, err)
+	assert.Positive(t, wait)
+
+	assert.NoError(t, store.Update("bucket", RateLimitBucket{Limit: 2, Remaining: 1, ResetAfter: time.Minute}))
+	wait, err = store.Reserve("bucket", 0)
+	assert.NoError(t, err)
+	assert.Zero(t, wait)
+	wait, err = store.Reserve("bucket", 0)
+	assert.NoError(t, err)
+	assert.Greater(t, wait, 50*time.Second)
+
+	// global limit
+	wait, err = store.Reserve("other", 1)
+	assert.NoError(t, err)
+	assert.Positive(t, wait)
+
+	assert.NoError(t, store.SetGlobalReset(time.Hour))
+	wait, err = store.Reserve("another", 0)
+	assert.NoError(t, err)
+	assert.Greater(t, wait, 50*time.Minute)
+}
+
+func TestDistributedRateLimiter(t *testing.T) {
+	server := httptest.NewServer(NewRateLimitStoreHandler(NewMemoryRateLimitStore()))
+	defer server.Close()
+
+	limiter1 := NewDistributedRateLimiter(NewHTTPRateLimitStore(server.URL, server.Client()))
+	limiter2 := NewDistributedRateLimiter(NewHTTPRateLimitStore(server.URL, server.Client()))
+
+	endpoint := GetMessage.Compile(nil, 1, 2)
+	assert.NoError(t, limiter1.WaitBucket(context.Background(), endpoint))
+
+	rs := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	rs.Header.Set("X-RateLimit-Bucket", "abc")
+	rs.Header.Set("X-RateLimit-Limit", "2")
+	rs.Header.Set("X-RateLimit-Remaining", "1")
+	rs.Header.Set("X-RateLimit-Reset-After", "60")
+	rs.Header.Set("via", "1.1 google")
+	assert.NoError(t, limiter1.UnlockBucket(endpoint, rs))
+
+	// the second process knows the bucket hash & limits of the first one
+	assert.NoError(t, limiter2.WaitBucket(context.Background(), endpoint))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter2.WaitBucket(ctx, endpoint), context.DeadlineExceeded)
+}
+
+func TestDistributedRateLimiterNoResponse(t *testing.T) {
+	limiter := NewDistributedRateLimiter(NewMemoryRateLimitStore())
+	endpoint := GetMessage.Compile(nil, 1, 2)
+
+	assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+	// the request failed before Discord told us the limits of the bucket
+	assert.NoError(t, limiter.UnlockBucket(endpoint, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, limiter.WaitBucket(ctx, endpoint))
+
+	// a response without a bucket header gives back the reserved request too
+	assert.NoError(t, limiter.UnlockBucket(endpoint, &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}))
+	assert.NoError(t, limiter.WaitBucket(ctx, endpoint))
+}
+
+func TestRateLimitWaitBucket(t *testing.T) {
+	rs := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	rs.Header.Set("X-RateLimit-Bucket", "abc")
+	rs.Header.Set("X-RateLimit-Limit", "5")
+	rs.Header.Set("X-RateLimit-Remaining", "4")
+	rs.Header.Set("X-RateLimit-Reset-After", "60")
+
+	for name, newLimiter := range map[string]func(opts ...RateLimiterConfigOpt) RateLimiter{
+		"memory": NewRateLimiter,
+		"distributed": func(opts ...RateLimiterConfigOpt) RateLimiter {
+			return NewDistributedRateLimiter(NewMemoryRateLimitStore(), opts...)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			collector := metrics.NewCollector()
+			limiter := newLimiter(WithRateLimiterMetrics(collector))
+			endpoint := GetMessage.Compile(nil, 1, 2)
+
+			// the first request waits for the route as the bucket is not known yet
+			assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+			assert.NoError(t, limiter.UnlockBucket(endpoint, rs))
+			assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+			assert.NoError(t, limiter.UnlockBucket(endpoint, rs))
+
+			buf := &bytes.Buffer{}
+			_, err := collector.WriteTo(buf)
+			assert.NoError(t, err)
+			assert.Contains(t, buf.String(), `disgo_rest_rate_limit_wait_seconds_count{bucket="GET+/channels/{channel.id}/messages/{message.id}"} 1`)
+			assert.Contains(t, buf.String(), `disgo_rest_rate_limit_wait_seconds_count{bucket="abc"} 1`)
+		})
+	}
+}
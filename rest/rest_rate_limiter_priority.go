@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"container/heap"
+	"context"
+	"strings"
+	"sync"
+)
+
+// Priority decides the order in which requests waiting for the same rate limit bucket or the global rate limit are sent.
+// Requests with a higher Priority are sent first, requests with the same Priority in the order they were made.
+type Priority int
+
+// The default Priority(s). Any other int can be used as well.
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+type priorityKey struct{}
+
+// WithPriorityContext returns a copy of the given context.Context with the given Priority, which the RateLimiter uses.
+func WithPriorityContext(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the Priority of the given context.Context and whether it was set.
+func PriorityFromContext(ctx context.Context) (Priority, bool) {
+	priority, ok := ctx.Value(priorityKey{}).(Priority)
+	return priority, ok
+}
+
+// requestPriority returns the Priority of the request. Interaction responses & followups default to PriorityHigh as they need to be sent in time.
+func requestPriority(ctx context.Context, endpoint *CompiledEndpoint) Priority {
+	if priority, ok := PriorityFromContext(ctx); ok {
+		return priority
+	}
+	if isInteractionEndpoint(endpoint.Endpoint) {
+		return PriorityHigh
+	}
+	return PriorityNormal
+}
+
+// isInteractionEndpoint returns whether the Endpoint is an interaction response or followup, which are not bound to the global rate limit.
+func isInteractionEndpoint(endpoint *Endpoint) bool {
+	return strings.HasPrefix(endpoint.Route, "/interactions/") || strings.HasPrefix(endpoint.Route, "/webhooks/{application.id}/{interaction.token}")
+}
+
+// priorityMutex is a mutex which hands the lock to the waiter with the highest Priority.
+type priorityMutex struct {
+	mu      sync.Mutex
+	locked  bool
+	waiters priorityWaiters
+	seq     uint64
+}
+
+type priorityWaiter struct {
+	priority Priority
+	seq      uint64
+	index    int
+	granted  bool
+	ch       chan struct{}
+}
+
+// Lock locks the mutex or waits until it is handed to this caller or the context is done.
+func (m *priorityMutex) Lock(ctx context.Context, priority Priority) error {
+	m.mu.Lock()
+	if !m.locked {
+		m.locked = true
+		m.mu.Unlock()
+		return nil
+	}
+	w := &priorityWaiter{
+		priority: priority,
+		seq:      m.seq,
+		ch:       make(chan struct{}),
+	}
+	m.seq++
+	heap.Push(&m.waiters, w)
+	m.mu.Unlock()
+
+	select {
+	case <-w.ch:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		if w.granted {
+			// we got the lock while giving up, pass it on
+			m.mu.Unlock()
+			m.Unlock()
+		} else {
+			heap.Remove(&m.waiters, w.index)
+			m.mu.Unlock()
+		}
+		return ctx.Err()
+	}
+}
+
+// TryLock locks the mutex if it is not locked and returns whether it did.
+func (m *priorityMutex) TryLock() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.locked {
+		return false
+	}
+	m.locked = true
+	return true
+}
+
+// Unlock hands the lock to the waiter with the highest Priority or unlocks the mutex if there is none.
+func (m *priorityMutex) Unlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.waiters.Len() == 0 {
+		m.locked = false
+		return
+	}
+	w := heap.Pop(&m.waiters).(*priorityWaiter)
+	w.granted = true
+	close(w.ch)
+}
+
+// Waiting returns the number of callers waiting for the lock.
+func (m *priorityMutex) Waiting() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.waiters.Len()
+}
+
+type priorityWaiters []*priorityWaiter
+
+func (w priorityWaiters) Len() int {
+	return len(w)
+}
+
+func (w priorityWaiters) Less(i, j int) bool {
+	if w[i].priority != w[j].priority {
+		return w[i].priority > w[j].priority
+	}
+	return w[i].seq < w[j].seq
+}
+
+func (w priorityWaiters) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index = i
+	w[j].index = j
+}
+
+func (w *priorityWaiters) Push(x any) {
+	waiter := x.(*priorityWaiter)
+	waiter.index = len(*w)
+	*w = append(*w, waiter)
+}
+
+func (w *priorityWaiters) Pop() any {
+	old := *w
+	n := len(old)
+	waiter := old[n-1]
+	old[n-1] = nil
+	*w = old[:n-1]
+	return waiter
+}
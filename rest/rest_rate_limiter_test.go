@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityMutex(t *testing.T) {
+	var m priorityMutex
+	assert.NoError(t, m.Lock(context.Background(), PriorityNormal))
+
+	var (
+		mu    sync.Mutex
+		order []Priority
+		wg    sync.WaitGroup
+	)
+	for _, priority := range []Priority{PriorityLow, PriorityNormal, PriorityHigh} {
+		wg.Add(1)
+		go func(priority Priority) {
+			defer wg.Done()
+			assert.NoError(t, m.Lock(context.Background(), priority))
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+			m.Unlock()
+		}(priority)
+		// make sure the waiters are queued in order
+		for m.Waiting() != int(priority)+2 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.Lock(ctx, PriorityHigh), context.DeadlineExceeded)
+	assert.Equal(t, 3, m.Waiting())
+
+	m.Unlock()
+	wg.Wait()
+	assert.Equal(t, []Priority{PriorityHigh, PriorityNormal, PriorityLow}, order)
+}
+
+func TestRateLimiterQueueSize(t *testing.T) {
+	limiter := NewRateLimiter(WithMaxBucketQueueSize(1))
+	endpoint := GetMessage.Compile(nil, 1, 2)
+
+	assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+	go func() {
+		_ = limiter.WaitBucket(context.Background(), endpoint)
+	}()
+	for limiter.(*rateLimiterImpl).getBucket(endpoint, false).mu.Waiting() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.ErrorIs(t, limiter.WaitBucket(context.Background(), endpoint), ErrRateLimitQueueFull)
+}
+
+func TestRateLimiterGlobalRateLimit(t *testing.T) {
+	limiter := NewRateLimiter(WithGlobalRateLimit(2))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		endpoint := GetMessage.Compile(nil, i, 1)
+		assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+		assert.NoError(t, limiter.UnlockBucket(endpoint, nil))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// interaction endpoints are not bound to the global rate limit
+	start = time.Now()
+	endpoint := CreateInteractionResponse.Compile(nil, 1, "token")
+	assert.NoError(t, limiter.WaitBucket(context.Background(), endpoint))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
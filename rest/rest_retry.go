@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// DefaultRetryPolicy returns the RetryPolicy which is used by default.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// RetryPolicy decides if & how requests are retried when they failed due to a network error or a 5xx response.
+// Rate limited requests are retried by the RateLimiter. See RateLimiter.MaxRetries.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. 0 disables retries.
+	MaxRetries int
+	// MinBackoff is the backoff before the first retry. It doubles with every retry up to MaxBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum backoff between two retries.
+	MaxBackoff time.Duration
+	// RetryNonIdempotent also retries POST & PATCH requests.
+	// Those might be executed twice if the request reached Discord but the response got lost, for example creating a message twice.
+	// Single requests can be marked as safe to retry with WithIdempotent instead.
+	RetryNonIdempotent bool
+}
+
+// Backoff returns the duration to wait before the given retry, starting at 1. It grows exponentially & is jittered to avoid all clients retrying at once.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := p.MinBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// equal jitter: half fixed, half random
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// canRetry returns whether a request with the given method can be retried by this RetryPolicy.
+func (p RetryPolicy) canRetry(method string, idempotent bool) bool {
+	if idempotent || p.RetryNonIdempotent {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait waits for the backoff of the given retry or until the context is done.
+func (p RetryPolicy) wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.Backoff(retry))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RetryError is returned by Client.Do when a request was made more than once & still failed.
+// It wraps the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("request failed after %d attempts: %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
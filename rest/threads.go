@@ -27,6 +27,8 @@ type Threads interface {
 
 	GetPublicArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
 	GetPrivateArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
+	GetPublicArchivedThreadsIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.GuildThread]
+	GetPrivateArchivedThreadsIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.GuildThread]
 	GetJoinedPrivateArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
 }
 
@@ -104,6 +106,31 @@ func (s *threadImpl) GetPrivateArchivedThreads(channelID snowflake.ID, before ti
 	return
 }
 
+func (s *threadImpl) GetPublicArchivedThreadsIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.GuildThread] {
+	return newIterator(100, opts, archivedThreadsPager(channelID, s.GetPublicArchivedThreads))
+}
+
+func (s *threadImpl) GetPrivateArchivedThreadsIterator(channelID snowflake.ID, opts ...RequestOpt) *Iterator[discord.GuildThread] {
+	return newIterator(100, opts, archivedThreadsPager(channelID, s.GetPrivateArchivedThreads))
+}
+
+// archivedThreadsPager pages archived threads by the archive timestamp of the last thread
+func archivedThreadsPager(channelID snowflake.ID, getThreads func(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (*discord.GetThreads, error)) func() pager[discord.GuildThread] {
+	return func() pager[discord.GuildThread] {
+		var before time.Time
+		return func(limit int, opts []RequestOpt) ([]discord.GuildThread, bool, error) {
+			threads, err := getThreads(channelID, before, limit, opts...)
+			if err != nil || threads == nil {
+				return nil, false, err
+			}
+			if len(threads.Threads) > 0 {
+				before = threads.Threads[len(threads.Threads)-1].ThreadMetadata.ArchiveTimestamp
+			}
+			return threads.Threads, threads.HasMore, nil
+		}
+	}
+}
+
 func (s *threadImpl) GetJoinedPrivateArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error) {
 	queryValues := discord.QueryValues{}
 	if !before.IsZero() {
@@ -15,6 +15,7 @@ type Users interface {
 	GetUser(userID snowflake.ID, opts ...RequestOpt) (*discord.User, error)
 	UpdateSelfUser(selfUserUpdate discord.SelfUserUpdate, opts ...RequestOpt) (*discord.OAuth2User, error)
 	GetGuilds(before int, after int, limit int, opts ...RequestOpt) ([]discord.OAuth2Guild, error)
+	GetGuildsIterator(opts ...RequestOpt) *Iterator[discord.OAuth2Guild]
 	LeaveGuild(guildID snowflake.ID, opts ...RequestOpt) error
 	GetDMChannels(opts ...RequestOpt) ([]discord.Channel, error)
 	CreateDMChannel(userID snowflake.ID, opts ...RequestOpt) (*discord.DMChannel, error)
@@ -49,6 +50,19 @@ func (s *userImpl) GetGuilds(before int, after int, limit int, opts ...RequestOp
 	return
 }
 
+func (s *userImpl) GetGuildsIterator(opts ...RequestOpt) *Iterator[discord.OAuth2Guild] {
+	return newIterator(200, opts, func() pager[discord.OAuth2Guild] {
+		var after snowflake.ID
+		return func(limit int, opts []RequestOpt) ([]discord.OAuth2Guild, bool, error) {
+			guilds, err := s.GetGuilds(0, int(after), limit, opts...)
+			if len(guilds) > 0 {
+				after = guilds[len(guilds)-1].ID
+			}
+			return guilds, len(guilds) == limit, err
+		}
+	})
+}
+
 func (s *userImpl) LeaveGuild(guildID snowflake.ID, opts ...RequestOpt) error {
 	return s.client.Do(LeaveGuild.Compile(nil, guildID), nil, nil, opts...)
 }
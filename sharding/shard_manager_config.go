@@ -25,6 +25,7 @@ type Config struct {
 	GatewayConfigOpts         []gateway.ConfigOpt
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
+	SessionStore              gateway.SessionStore
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
@@ -38,6 +39,11 @@ func (c *Config) Apply(opts []ConfigOpt) {
 	if c.RateLimiter == nil {
 		c.RateLimiter = NewRateLimiter(c.RateRateLimiterConfigOpts...)
 	}
+	// the shards wait for their bucket before every identify, so shards which resume don't need to wait
+	c.GatewayConfigOpts = append([]gateway.ConfigOpt{gateway.WithIdentifyRateLimiter(c.RateLimiter)}, c.GatewayConfigOpts...)
+	if c.SessionStore != nil {
+		c.GatewayConfigOpts = append([]gateway.ConfigOpt{gateway.WithSessionStore(c.SessionStore)}, c.GatewayConfigOpts...)
+	}
 }
 
 // WithLogger sets the logger of the ShardManager.
@@ -108,3 +114,11 @@ func WithRateRateLimiterConfigOpt(opts ...RateLimiterConfigOpt) ConfigOpt {
 		config.RateRateLimiterConfigOpts = append(config.RateRateLimiterConfigOpts, opts...)
 	}
 }
+
+// WithSessionStore sets the gateway.SessionStore shared by all shards of the ShardManager.
+// Shards resume their stored sessions when opened and write them when closed. See gateway.WithSessionStore for more information.
+func WithSessionStore(sessionStore gateway.SessionStore) ConfigOpt {
+	return func(config *Config) {
+		config.SessionStore = sessionStore
+	}
+}
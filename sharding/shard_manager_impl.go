@@ -66,12 +66,6 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := m.config.RateLimiter.WaitBucket(context.TODO(), shardID); err != nil {
-				m.config.Logger.Errorf("failed to wait shard bucket %d: %s", shardID, err)
-				return
-			}
-			defer m.config.RateLimiter.UnlockBucket(shardID)
-
 			newShard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(newShardCount))...)
 			m.shards[shardID] = newShard
 			if err := newShard.Open(context.TODO()); err != nil {
@@ -95,17 +89,12 @@ func (m *shardManagerImpl) Open(ctx context.Context) {
 			continue
 		}
 
+		shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(m.config.ShardCount))...)
+		m.shards[shardID] = shard
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := m.config.RateLimiter.WaitBucket(ctx, shardID); err != nil {
-				m.config.Logger.Errorf("failed to wait shard bucket %d: %s", shardID, err)
-				return
-			}
-			defer m.config.RateLimiter.UnlockBucket(shardID)
-
-			shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(m.config.ShardCount))...)
-			m.shards[shardID] = shard
 			if err := shard.Open(ctx); err != nil {
 				m.config.Logger.Errorf("failed to open shard %d: %s", shardID, err)
 			}
@@ -139,10 +128,6 @@ func (m *shardManagerImpl) OpenShard(ctx context.Context, shardID int) error {
 func (m *shardManagerImpl) openShard(ctx context.Context, shardID int, shardCount int) error {
 	m.config.Logger.Debugf("opening shard %d...", shardID)
 
-	if err := m.config.RateLimiter.WaitBucket(ctx, shardID); err != nil {
-		return err
-	}
-	defer m.config.RateLimiter.UnlockBucket(shardID)
 	shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount))...)
 
 	m.shardsMu.Lock()
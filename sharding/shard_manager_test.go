@@ -0,0 +1,66 @@
+package sharding_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/disgotest"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingRateLimiter struct {
+	sharding.RateLimiter
+	mu    sync.Mutex
+	waits map[int]int
+}
+
+func (r *recordingRateLimiter) WaitBucket(ctx context.Context, shardID int) error {
+	r.mu.Lock()
+	r.waits[shardID]++
+	r.mu.Unlock()
+	return r.RateLimiter.WaitBucket(ctx, shardID)
+}
+
+func TestShardManager_FailedResumeWaitsForIdentifyBucket(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := disgotest.New()
+	defer server.Close()
+
+	// the server doesn't know the stored session, so the resume fails & the shard has to identify
+	store := gateway.NewFileSessionStore(filepath.Join(t.TempDir(), "sessions.json"))
+	require.NoError(t, store.PutSession(0, gateway.Session{
+		SessionID:            "expired",
+		LastSequenceReceived: 1,
+		ResumeGatewayURL:     server.GatewayURL(),
+		ShardCount:           1,
+	}))
+
+	rateLimiter := &recordingRateLimiter{RateLimiter: sharding.NewRateLimiter(), waits: map[int]int{}}
+	manager := sharding.New(server.Token(), func(gateway.EventType, int, int, gateway.EventData) {},
+		sharding.WithShardIDs(0),
+		sharding.WithShardCount(1),
+		sharding.WithSessionStore(store),
+		sharding.WithRateLimiter(rateLimiter),
+		sharding.WithGatewayConfigOpts(gateway.WithURL(server.GatewayURL())),
+	)
+	manager.Open(ctx)
+	defer manager.Close(context.Background())
+
+	require.NoError(t, server.WaitForShard(ctx, 0))
+	shard, ok := server.Shard(0)
+	require.True(t, ok)
+	assert.Equal(t, 1, shard.Identifies)
+	assert.Equal(t, 0, shard.Resumes)
+
+	rateLimiter.mu.Lock()
+	defer rateLimiter.mu.Unlock()
+	assert.Equal(t, 1, rateLimiter.waits[0])
+}
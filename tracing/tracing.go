@@ -0,0 +1,85 @@
+// Package tracing provides a small Tracer interface which disgo uses to create spans for handled events & REST requests.
+// Spans are linked through the context.Context of events, which REST requests made with the events.GenericEvent Rest use by default:
+//
+//	client.AddEventListeners(bot.NewListenerFunc(func(e *events.MessageCreate) {
+//		_, _ = e.Rest().CreateMessage(e.ChannelID, discord.MessageCreate{Content: "pong"})
+//	}))
+//
+// Requests made with Client().Rest() need the context.Context passed by hand with rest.WithCtx(e.Ctx()).
+// The span of an event ends once its handler & all EventListener(s), including async ones, returned.
+//
+// The Tracer can be adapted to OpenTelemetry without disgo depending on it:
+//
+//	type otelTracer struct{ tracer trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string, attributes ...tracing.Attribute) (context.Context, tracing.Span) {
+//		ctx, span := t.tracer.Start(ctx, name)
+//		s := otelSpan{span}
+//		s.SetAttributes(attributes...)
+//		return ctx, s
+//	}
+//
+//	type otelSpan struct{ span trace.Span }
+//
+//	func (s otelSpan) SetAttributes(attributes ...tracing.Attribute) {
+//		for _, a := range attributes {
+//			s.span.SetAttributes(attribute.String(a.Key, fmt.Sprint(a.Value)))
+//		}
+//	}
+//	func (s otelSpan) RecordError(err error) { s.span.RecordError(err); s.span.SetStatus(codes.Error, err.Error()) }
+//	func (s otelSpan) End()                  { s.span.End() }
+package tracing
+
+import (
+	"context"
+)
+
+// Tracer creates Span(s). Implementations must be safe for concurrent use.
+type Tracer interface {
+	// Start starts a new Span with the given name & Attribute(s) as child of the Span in the given context.Context if there is one.
+	// The returned context.Context contains the new Span.
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Span is a single operation of a trace.
+type Span interface {
+	// SetAttributes adds the given Attribute(s) to the Span.
+	SetAttributes(attributes ...Attribute)
+
+	// RecordError marks the Span as failed with the given error.
+	RecordError(err error)
+
+	// End ends the Span.
+	End()
+}
+
+// Attribute is a key value pair describing a Span.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// String returns a new Attribute with a string value.
+func String(key string, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns a new Attribute with an int value.
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Noop is a Tracer which creates Span(s) doing nothing. It is used by default.
+var Noop Tracer = noopTracer{}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) End()                       {}
@@ -0,0 +1,110 @@
+package voice
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+type (
+	// OpusFrameReceiver is used to receive opus frames from an AudioReceiver.
+	OpusFrameReceiver interface {
+		// ReceiveOpusFrame receives an opus frame of the given user.
+		// userID may be 0 if the user of the ssrc is not known yet.
+		ReceiveOpusFrame(userID snowflake.ID, packet *Packet) error
+
+		// CleanupUser is called when a user disconnects from the voice channel.
+		CleanupUser(userID snowflake.ID)
+
+		// Close closes the OpusFrameReceiver.
+		Close()
+	}
+
+	// AudioReceiverCreateFunc is used to create a new AudioReceiver reading audio from the given Conn.
+	AudioReceiverCreateFunc func(logger log.Logger, receiver OpusFrameReceiver, conn Conn) AudioReceiver
+
+	// AudioReceiver is used to receive audio from a Conn.
+	AudioReceiver interface {
+		// Open starts receiving audio from the Conn.
+		Open()
+
+		// CleanupUser cleans up the given user.
+		CleanupUser(userID snowflake.ID)
+
+		// Close stops receiving audio from the Conn.
+		Close()
+	}
+)
+
+// NewAudioReceiver creates a new AudioReceiver reading audio from the given Conn and passing it to the given OpusFrameReceiver.
+func NewAudioReceiver(logger log.Logger, opusReceiver OpusFrameReceiver, conn Conn) AudioReceiver {
+	return &defaultAudioReceiver{
+		logger:       logger,
+		opusReceiver: opusReceiver,
+		conn:         conn,
+	}
+}
+
+type defaultAudioReceiver struct {
+	logger       log.Logger
+	cancel       context.CancelFunc
+	opusReceiver OpusFrameReceiver
+	conn         Conn
+}
+
+func (r *defaultAudioReceiver) Open() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go r.receive(ctx)
+}
+
+func (r *defaultAudioReceiver) receive(ctx context.Context) {
+	defer r.logger.Debug("closing audio receiver goroutine...")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			r.receiveFrame()
+		}
+	}
+}
+
+func (r *defaultAudioReceiver) receiveFrame() {
+	udp := r.conn.UDP()
+	if udp == nil {
+		time.Sleep(OpusFrameDuration)
+		return
+	}
+	packet, err := udp.ReadPacket()
+	if errors.Is(err, net.ErrClosed) || errors.Is(err, ErrUDPConnNotOpen) {
+		r.Close()
+		return
+	}
+	if err != nil {
+		r.logger.Error("error while reading packet: ", err)
+		return
+	}
+	if r.opusReceiver == nil {
+		return
+	}
+	if err = r.opusReceiver.ReceiveOpusFrame(r.conn.UserIDBySSRC(packet.SSRC), packet); err != nil {
+		r.logger.Error("error while receiving opus frame: ", err)
+	}
+}
+
+func (r *defaultAudioReceiver) CleanupUser(userID snowflake.ID) {
+	if r.opusReceiver != nil {
+		r.opusReceiver.CleanupUser(userID)
+	}
+}
+
+func (r *defaultAudioReceiver) Close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
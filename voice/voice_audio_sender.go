@@ -0,0 +1,121 @@
+package voice
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/log"
+)
+
+type (
+	// OpusFrameProvider is used to provide opus frames to an AudioSender.
+	OpusFrameProvider interface {
+		// ProvideOpusFrame provides an opus frame to the AudioSender.
+		// Returning nil will make the AudioSender pause sending until the next frame is provided.
+		ProvideOpusFrame() ([]byte, error)
+
+		// Close closes the OpusFrameProvider.
+		Close()
+	}
+
+	// AudioSenderCreateFunc is used to create a new AudioSender sending audio to the given Conn.
+	AudioSenderCreateFunc func(logger log.Logger, provider OpusFrameProvider, conn Conn) AudioSender
+
+	// AudioSender is used to send audio to a Conn.
+	AudioSender interface {
+		// Open starts sending opus frames every OpusFrameDuration.
+		Open()
+
+		// Close stops sending opus frames.
+		Close()
+	}
+)
+
+// NewAudioSender creates a new AudioSender sending audio from the given OpusFrameProvider to the given Conn.
+func NewAudioSender(logger log.Logger, provider OpusFrameProvider, conn Conn) AudioSender {
+	return &defaultAudioSender{
+		logger:   logger,
+		provider: provider,
+		conn:     conn,
+		speaking: false,
+	}
+}
+
+type defaultAudioSender struct {
+	logger   log.Logger
+	cancel   context.CancelFunc
+	provider OpusFrameProvider
+	conn     Conn
+
+	silentFrames int
+	speaking     bool
+}
+
+func (s *defaultAudioSender) Open() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.send(ctx)
+}
+
+func (s *defaultAudioSender) send(ctx context.Context) {
+	defer s.logger.Debug("closing audio sender goroutine...")
+	ticker := time.NewTicker(OpusFrameDuration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sendFrame()
+		}
+	}
+}
+
+func (s *defaultAudioSender) sendFrame() {
+	if s.conn.UDP() == nil {
+		return
+	}
+
+	opus, err := s.provider.ProvideOpusFrame()
+	if err != nil {
+		s.logger.Error("error while providing opus frame: ", err)
+		return
+	}
+	if len(opus) == 0 {
+		if s.silentFrames > 0 {
+			if _, err = s.conn.UDP().Write(SilenceAudioFrame); err != nil {
+				s.logger.Error("error while writing silence frame: ", err)
+			}
+			s.silentFrames--
+		} else if s.speaking {
+			s.setSpeaking(SpeakingFlagNone)
+		}
+		return
+	}
+
+	if !s.speaking {
+		s.setSpeaking(SpeakingFlagMicrophone)
+	}
+
+	if _, err = s.conn.UDP().Write(opus); err != nil {
+		s.logger.Error("error while writing opus frame: ", err)
+	}
+	s.silentFrames = 5
+}
+
+func (s *defaultAudioSender) setSpeaking(flags SpeakingFlags) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.conn.SetSpeaking(ctx, flags); err != nil {
+		s.logger.Error("error while setting speaking flags: ", err)
+		return
+	}
+	s.speaking = flags != SpeakingFlagNone
+}
+
+func (s *defaultAudioSender) Close() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
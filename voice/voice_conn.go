@@ -0,0 +1,370 @@
+package voice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// ErrConnClosed is returned when a Conn is closed before it finished connecting.
+var ErrConnClosed = errors.New("voice connection closed")
+
+type (
+	// StateUpdateFunc is used to send a gateway.MessageDataVoiceStateUpdate over the main gateway to join, move or leave a voice channel.
+	StateUpdateFunc func(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error
+
+	// ConnCreateFunc is a type that is used to create a new Conn.
+	ConnCreateFunc func(guildID snowflake.ID, userID snowflake.ID, stateUpdateFunc StateUpdateFunc, removeConnFunc func(), opts ...ConnConfigOpt) Conn
+)
+
+// Conn is a complete voice connection to a Discord voice server.
+// It combines the voice Gateway and the UDPConn and handles the handshake between them.
+type Conn interface {
+	// Gateway returns the voice Gateway of the Conn.
+	Gateway() Gateway
+
+	// UDP returns the UDPConn of the Conn. It is nil until the voice Gateway received its GatewayMessageDataReady.
+	UDP() UDPConn
+
+	// GuildID returns the guild id of the Conn.
+	GuildID() snowflake.ID
+
+	// ChannelID returns the channel id the Conn is currently connected to.
+	ChannelID() *snowflake.ID
+
+	// UserIDBySSRC returns the user id of the given ssrc or 0 if unknown.
+	UserIDBySSRC(ssrc uint32) snowflake.ID
+
+	// SetSpeaking sends a GatewayMessageDataSpeaking with the given SpeakingFlags.
+	SetSpeaking(ctx context.Context, flags SpeakingFlags) error
+
+	// SetOpusFrameProvider sets the OpusFrameProvider used to send audio. Passing nil stops sending audio.
+	SetOpusFrameProvider(provider OpusFrameProvider)
+
+	// SetOpusFrameReceiver sets the OpusFrameReceiver used to receive audio. Passing nil stops receiving audio.
+	SetOpusFrameReceiver(receiver OpusFrameReceiver)
+
+	// Open sends a voice state update to join the given channel and blocks until the voice connection is ready or the context is done.
+	Open(ctx context.Context, channelID snowflake.ID, selfMute bool, selfDeaf bool) error
+
+	// Close sends a voice state update to leave the voice channel and closes all underlying connections.
+	Close(ctx context.Context)
+
+	// HandleVoiceStateUpdate provides the gateway.EventVoiceStateUpdate of the bot user to the Conn.
+	HandleVoiceStateUpdate(update gateway.EventVoiceStateUpdate)
+
+	// HandleVoiceServerUpdate provides the gateway.EventVoiceServerUpdate to the Conn.
+	HandleVoiceServerUpdate(update gateway.EventVoiceServerUpdate)
+}
+
+var _ Conn = (*connImpl)(nil)
+
+// NewConn creates a new Conn for the given guild & user.
+func NewConn(guildID snowflake.ID, userID snowflake.ID, stateUpdateFunc StateUpdateFunc, removeConnFunc func(), opts ...ConnConfigOpt) Conn {
+	config := DefaultConnConfig()
+	config.Apply(opts)
+
+	conn := &connImpl{
+		config:          *config,
+		stateUpdateFunc: stateUpdateFunc,
+		removeConnFunc:  removeConnFunc,
+		state: State{
+			GuildID: guildID,
+			UserID:  userID,
+		},
+		ssrcs: map[uint32]snowflake.ID{},
+	}
+	conn.gateway = config.GatewayCreateFunc(conn.handleMessage, conn.handleGatewayClose, append([]GatewayConfigOpt{WithGatewayLogger(config.Logger)}, config.GatewayConfigOpts...)...)
+
+	return conn
+}
+
+type connImpl struct {
+	config          ConnConfig
+	stateUpdateFunc StateUpdateFunc
+	removeConnFunc  func()
+
+	state   State
+	stateMu sync.Mutex
+	// serverUpdated is set when a voice server update was received & the voice gateway still needs to be opened with it
+	serverUpdated bool
+
+	gateway Gateway
+
+	udp   UDPConn
+	udpMu sync.Mutex
+
+	audioSender   AudioSender
+	audioReceiver AudioReceiver
+	audioMu       sync.Mutex
+
+	ssrcs   map[uint32]snowflake.ID
+	ssrcsMu sync.Mutex
+
+	openedChan chan error
+	openedOnce *sync.Once
+}
+
+func (c *connImpl) Gateway() Gateway {
+	return c.gateway
+}
+
+func (c *connImpl) UDP() UDPConn {
+	c.udpMu.Lock()
+	defer c.udpMu.Unlock()
+	return c.udp
+}
+
+func (c *connImpl) GuildID() snowflake.ID {
+	return c.state.GuildID
+}
+
+func (c *connImpl) ChannelID() *snowflake.ID {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	return c.state.ChannelID
+}
+
+func (c *connImpl) UserIDBySSRC(ssrc uint32) snowflake.ID {
+	c.ssrcsMu.Lock()
+	defer c.ssrcsMu.Unlock()
+	return c.ssrcs[ssrc]
+}
+
+func (c *connImpl) SetSpeaking(ctx context.Context, flags SpeakingFlags) error {
+	return c.gateway.Send(ctx, OpcodeSpeaking, GatewayMessageDataSpeaking{
+		Speaking: flags,
+		SSRC:     c.gateway.SSRC(),
+	})
+}
+
+func (c *connImpl) SetOpusFrameProvider(provider OpusFrameProvider) {
+	c.audioMu.Lock()
+	defer c.audioMu.Unlock()
+	if c.audioSender != nil {
+		c.audioSender.Close()
+		c.audioSender = nil
+	}
+	if provider == nil {
+		return
+	}
+	c.audioSender = c.config.AudioSenderCreateFunc(c.config.Logger, provider, c)
+	c.audioSender.Open()
+}
+
+func (c *connImpl) SetOpusFrameReceiver(receiver OpusFrameReceiver) {
+	c.audioMu.Lock()
+	defer c.audioMu.Unlock()
+	if c.audioReceiver != nil {
+		c.audioReceiver.Close()
+		c.audioReceiver = nil
+	}
+	if receiver == nil {
+		return
+	}
+	c.audioReceiver = c.config.AudioReceiverCreateFunc(c.config.Logger, receiver, c)
+	c.audioReceiver.Open()
+}
+
+func (c *connImpl) Open(ctx context.Context, channelID snowflake.ID, selfMute bool, selfDeaf bool) error {
+	c.stateMu.Lock()
+	openedChan := make(chan error, 1)
+	c.openedChan = openedChan
+	c.openedOnce = &sync.Once{}
+	// wait for the session id of the new voice state
+	c.state.SessionID = ""
+	c.serverUpdated = false
+	c.stateMu.Unlock()
+
+	if err := c.stateUpdateFunc(ctx, c.state.GuildID, &channelID, selfMute, selfDeaf); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-openedChan:
+		return err
+	}
+}
+
+func (c *connImpl) signalOpened(err error) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.openedOnce == nil {
+		return
+	}
+	c.openedOnce.Do(func() {
+		c.openedChan <- err
+	})
+}
+
+func (c *connImpl) Close(ctx context.Context) {
+	if err := c.stateUpdateFunc(ctx, c.state.GuildID, nil, false, false); err != nil {
+		c.config.Logger.Error("error while sending voice state update to leave voice channel: ", err)
+	}
+	c.close()
+}
+
+func (c *connImpl) close() {
+	c.audioMu.Lock()
+	if c.audioSender != nil {
+		c.audioSender.Close()
+	}
+	if c.audioReceiver != nil {
+		c.audioReceiver.Close()
+	}
+	c.audioMu.Unlock()
+
+	c.gateway.Close()
+
+	c.udpMu.Lock()
+	if c.udp != nil {
+		_ = c.udp.Close()
+		c.udp = nil
+	}
+	c.udpMu.Unlock()
+
+	c.signalOpened(ErrConnClosed)
+	if c.removeConnFunc != nil {
+		c.removeConnFunc()
+	}
+}
+
+func (c *connImpl) HandleVoiceStateUpdate(update gateway.EventVoiceStateUpdate) {
+	if update.GuildID != c.state.GuildID || update.UserID != c.state.UserID {
+		return
+	}
+
+	c.stateMu.Lock()
+	c.state.ChannelID = update.ChannelID
+	c.state.SessionID = update.SessionID
+	state, ok := c.openState()
+	c.stateMu.Unlock()
+
+	if update.ChannelID == nil {
+		c.close()
+		return
+	}
+	if ok {
+		go c.openGateway(state)
+	}
+}
+
+func (c *connImpl) HandleVoiceServerUpdate(update gateway.EventVoiceServerUpdate) {
+	if update.GuildID != c.state.GuildID || update.Endpoint == nil {
+		return
+	}
+
+	c.stateMu.Lock()
+	c.state.Token = update.Token
+	c.state.Endpoint = *update.Endpoint
+	c.serverUpdated = true
+	state, ok := c.openState()
+	c.stateMu.Unlock()
+
+	if ok {
+		go c.openGateway(state)
+	}
+}
+
+// openState returns the State to open the voice gateway with once both the session id & the voice server are known.
+// Whichever update completes the State opens the voice gateway. The caller must hold the stateMu lock.
+func (c *connImpl) openState() (State, bool) {
+	if !c.serverUpdated || c.state.SessionID == "" {
+		return State{}, false
+	}
+	c.serverUpdated = false
+	return c.state, true
+}
+
+func (c *connImpl) openGateway(state State) {
+	// the voice server changed, so we need to establish a new session
+	if c.gateway.Status() != GatewayStatusUnconnected {
+		c.gateway.Close()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := c.gateway.Open(ctx, state); err != nil {
+		c.config.Logger.Error("error while opening voice gateway: ", err)
+		c.signalOpened(err)
+	}
+}
+
+func (c *connImpl) handleMessage(op Opcode, data GatewayMessageData) {
+	switch d := data.(type) {
+	case GatewayMessageDataReady:
+		go c.handleReady(d)
+
+	case GatewayMessageDataSessionDescription:
+		if udp := c.UDP(); udp != nil {
+			udp.SetSecretKey(d.SecretKey)
+		}
+		c.signalOpened(nil)
+
+	case GatewayMessageDataSpeaking:
+		c.ssrcsMu.Lock()
+		c.ssrcs[d.SSRC] = d.UserID
+		c.ssrcsMu.Unlock()
+
+	case GatewayMessageDataClientDisconnect:
+		c.ssrcsMu.Lock()
+		for ssrc, userID := range c.ssrcs {
+			if userID == d.UserID {
+				delete(c.ssrcs, ssrc)
+			}
+		}
+		c.ssrcsMu.Unlock()
+
+		c.audioMu.Lock()
+		if c.audioReceiver != nil {
+			c.audioReceiver.CleanupUser(d.UserID)
+		}
+		c.audioMu.Unlock()
+	}
+
+	if c.config.EventHandlerFunc != nil {
+		c.config.EventHandlerFunc(op, data)
+	}
+}
+
+func (c *connImpl) handleReady(ready GatewayMessageDataReady) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c.udpMu.Lock()
+	if c.udp != nil {
+		_ = c.udp.Close()
+	}
+	udp := c.config.UDPConnCreateFunc(append([]UDPConnConfigOpt{WithUDPConnLogger(c.config.Logger)}, c.config.UDPConnConfigOpts...)...)
+	c.udp = udp
+	c.udpMu.Unlock()
+
+	address, port, err := udp.Open(ctx, ready.IP, ready.Port, ready.SSRC)
+	if err != nil {
+		c.config.Logger.Error("error while opening voice udp connection: ", err)
+		c.signalOpened(err)
+		return
+	}
+
+	if err = c.gateway.Send(ctx, OpcodeSelectProtocol, GatewayMessageDataSelectProtocol{
+		Protocol: ProtocolUDP,
+		Data: GatewayMessageDataSelectProtocolData{
+			Address: address,
+			Port:    port,
+			Mode:    EncryptionModeNormal,
+		},
+	}); err != nil {
+		c.config.Logger.Error("error while sending select protocol: ", err)
+		c.signalOpened(err)
+	}
+}
+
+func (c *connImpl) handleGatewayClose(_ Gateway, err error) {
+	c.config.Logger.Error("voice gateway closed. error: ", err)
+	c.close()
+}
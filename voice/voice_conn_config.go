@@ -0,0 +1,98 @@
+package voice
+
+import (
+	"github.com/disgoorg/log"
+)
+
+// DefaultConnConfig returns a ConnConfig with sensible defaults.
+func DefaultConnConfig() *ConnConfig {
+	return &ConnConfig{
+		Logger:                  log.Default(),
+		GatewayCreateFunc:       NewGateway,
+		UDPConnCreateFunc:       NewUDPConn,
+		AudioSenderCreateFunc:   NewAudioSender,
+		AudioReceiverCreateFunc: NewAudioReceiver,
+	}
+}
+
+// ConnConfig lets you configure your Conn instance.
+type ConnConfig struct {
+	Logger log.Logger
+
+	GatewayCreateFunc GatewayCreateFunc
+	GatewayConfigOpts []GatewayConfigOpt
+
+	UDPConnCreateFunc UDPConnCreateFunc
+	UDPConnConfigOpts []UDPConnConfigOpt
+
+	AudioSenderCreateFunc   AudioSenderCreateFunc
+	AudioReceiverCreateFunc AudioReceiverCreateFunc
+
+	EventHandlerFunc GatewayEventHandlerFunc
+}
+
+// ConnConfigOpt is a type alias for a function that takes a ConnConfig and is used to configure your Conn.
+type ConnConfigOpt func(config *ConnConfig)
+
+// Apply applies the given ConnConfigOpt(s) to the ConnConfig
+func (c *ConnConfig) Apply(opts []ConnConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithConnLogger sets the Logger for the Conn.
+func WithConnLogger(logger log.Logger) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithConnGatewayCreateFunc sets the GatewayCreateFunc for the Conn.
+func WithConnGatewayCreateFunc(gatewayCreateFunc GatewayCreateFunc) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.GatewayCreateFunc = gatewayCreateFunc
+	}
+}
+
+// WithConnGatewayConfigOpts lets you configure the default Gateway of the Conn.
+func WithConnGatewayConfigOpts(opts ...GatewayConfigOpt) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.GatewayConfigOpts = append(config.GatewayConfigOpts, opts...)
+	}
+}
+
+// WithUDPConnCreateFunc sets the UDPConnCreateFunc for the Conn.
+func WithUDPConnCreateFunc(udpConnCreateFunc UDPConnCreateFunc) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.UDPConnCreateFunc = udpConnCreateFunc
+	}
+}
+
+// WithUDPConnConfigOpts lets you configure the default UDPConn of the Conn.
+func WithUDPConnConfigOpts(opts ...UDPConnConfigOpt) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.UDPConnConfigOpts = append(config.UDPConnConfigOpts, opts...)
+	}
+}
+
+// WithConnAudioSenderCreateFunc sets the AudioSenderCreateFunc for the Conn.
+func WithConnAudioSenderCreateFunc(audioSenderCreateFunc AudioSenderCreateFunc) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.AudioSenderCreateFunc = audioSenderCreateFunc
+	}
+}
+
+// WithConnAudioReceiverCreateFunc sets the AudioReceiverCreateFunc for the Conn.
+func WithConnAudioReceiverCreateFunc(audioReceiverCreateFunc AudioReceiverCreateFunc) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.AudioReceiverCreateFunc = audioReceiverCreateFunc
+	}
+}
+
+// WithConnEventHandlerFunc sets a GatewayEventHandlerFunc which receives all voice gateway messages of the Conn.
+func WithConnEventHandlerFunc(eventHandlerFunc GatewayEventHandlerFunc) ConnConfigOpt {
+	return func(config *ConnConfig) {
+		config.EventHandlerFunc = eventHandlerFunc
+	}
+}
@@ -0,0 +1,243 @@
+package voice
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+const (
+	testGuildID   = snowflake.ID(1)
+	testChannelID = snowflake.ID(2)
+	testUserID    = snowflake.ID(3)
+	testOtherUser = snowflake.ID(4)
+	testSSRC      = uint32(100)
+	testOtherSSRC = uint32(200)
+)
+
+var testSecretKey = [32]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32}
+
+// startTestUDPServer starts a stand-in voice udp server which answers ip discovery, forwards the first audio packet to received and echoes it back with a different ssrc.
+func startTestUDPServer(t *testing.T, received chan<- []byte) *net.UDPConn {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+
+	go func() {
+		buff := make([]byte, 1400)
+		for {
+			n, addr, err := conn.ReadFromUDP(buff)
+			if err != nil {
+				return
+			}
+			if n == ipDiscoveryPacketSize && binary.BigEndian.Uint16(buff) == ipDiscoveryPacketTypeRequest {
+				response := make([]byte, ipDiscoveryPacketSize)
+				binary.BigEndian.PutUint16(response, ipDiscoveryPacketTypeResponse)
+				binary.BigEndian.PutUint16(response[2:], ipDiscoveryPacketLength)
+				copy(response[4:8], buff[4:8])
+				copy(response[8:], addr.IP.String())
+				binary.BigEndian.PutUint16(response[72:], uint16(addr.Port))
+				_, _ = conn.WriteToUDP(response, addr)
+				continue
+			}
+
+			var nonce [24]byte
+			copy(nonce[:], buff[:RTPHeaderSize])
+			opus, ok := secretbox.Open(nil, buff[RTPHeaderSize:n], &nonce, &testSecretKey)
+			if !ok {
+				continue
+			}
+			received <- opus
+
+			header := make([]byte, RTPHeaderSize)
+			copy(header, buff[:RTPHeaderSize])
+			binary.BigEndian.PutUint32(header[8:], testOtherSSRC)
+			copy(nonce[:], header)
+			_, _ = conn.WriteToUDP(secretbox.Seal(header, opus, &nonce, &testSecretKey), addr)
+		}
+	}()
+	return conn
+}
+
+// startTestGatewayServer starts a stand-in voice gateway which performs the identify & select protocol handshake.
+func startTestGatewayServer(t *testing.T, udpAddr *net.UDPAddr, identified chan<- GatewayMessageDataIdentify) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		send := func(op Opcode, d GatewayMessageData) {
+			data, _ := json.Marshal(GatewayMessage{Op: op, D: d})
+			_ = conn.WriteMessage(websocket.TextMessage, data)
+		}
+
+		send(OpcodeHello, GatewayMessageDataHello{HeartbeatInterval: 10000})
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var message GatewayMessage
+			if err = json.Unmarshal(data, &message); err != nil {
+				return
+			}
+			switch d := message.D.(type) {
+			case GatewayMessageDataIdentify:
+				identified <- d
+				send(OpcodeReady, GatewayMessageDataReady{
+					SSRC:  testSSRC,
+					IP:    udpAddr.IP.String(),
+					Port:  udpAddr.Port,
+					Modes: []EncryptionMode{EncryptionModeNormal},
+				})
+
+			case GatewayMessageDataSelectProtocol:
+				send(OpcodeSpeaking, GatewayMessageDataSpeaking{
+					Speaking: SpeakingFlagMicrophone,
+					SSRC:     testOtherSSRC,
+					UserID:   testOtherUser,
+				})
+				send(OpcodeSessionDescription, GatewayMessageDataSessionDescription{
+					Mode:      d.Data.Mode,
+					SecretKey: testSecretKey,
+				})
+
+			case GatewayMessageDataHeartbeat:
+				send(OpcodeHeartbeatACK, GatewayMessageDataHeartbeatACK(d))
+			}
+		}
+	}))
+}
+
+func TestConn_Open(t *testing.T) {
+	received := make(chan []byte, 1)
+	udpServer := startTestUDPServer(t, received)
+	defer udpServer.Close()
+
+	identified := make(chan GatewayMessageDataIdentify, 1)
+	gatewayServer := startTestGatewayServer(t, udpServer.LocalAddr().(*net.UDPAddr), identified)
+	defer gatewayServer.Close()
+
+	var conn Conn
+	stateUpdateFunc := func(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error {
+		if channelID == nil {
+			return nil
+		}
+		endpoint := strings.Replace(gatewayServer.URL, "http://", "ws://", 1)
+		go func() {
+			conn.HandleVoiceStateUpdate(gateway.EventVoiceStateUpdate{VoiceState: discord.VoiceState{
+				GuildID:   guildID,
+				ChannelID: channelID,
+				UserID:    testUserID,
+				SessionID: "session",
+			}})
+			conn.HandleVoiceServerUpdate(gateway.EventVoiceServerUpdate{
+				Token:    "token",
+				GuildID:  guildID,
+				Endpoint: &endpoint,
+			})
+		}()
+		return nil
+	}
+
+	manager := NewManager(stateUpdateFunc, testUserID, WithLogger(log.New(log.LstdFlags)))
+	conn = manager.CreateConn(testGuildID)
+	assert.Equal(t, conn, manager.GetConn(testGuildID))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, conn.Open(ctx, testChannelID, false, false))
+
+	identify := <-identified
+	assert.Equal(t, GatewayMessageDataIdentify{
+		GuildID:   testGuildID,
+		UserID:    testUserID,
+		SessionID: "session",
+		Token:     "token",
+	}, identify)
+	assert.Equal(t, testSSRC, conn.Gateway().SSRC())
+	assert.Equal(t, testChannelID, *conn.ChannelID())
+	assert.Equal(t, testOtherUser, conn.UserIDBySSRC(testOtherSSRC))
+
+	opus := []byte{0x01, 0x02, 0x03, 0x04}
+	_, err := conn.UDP().Write(opus)
+	require.NoError(t, err)
+
+	select {
+	case data := <-received:
+		assert.Equal(t, opus, data)
+	case <-ctx.Done():
+		t.Fatal("voice server did not receive the opus frame")
+	}
+
+	require.NoError(t, conn.UDP().SetReadDeadline(time.Now().Add(5*time.Second)))
+	packet, err := conn.UDP().ReadPacket()
+	require.NoError(t, err)
+	assert.Equal(t, testOtherSSRC, packet.SSRC)
+	assert.Equal(t, opus, packet.Opus)
+
+	conn.Close(ctx)
+	assert.Nil(t, manager.GetConn(testGuildID))
+}
+
+func TestConn_OpenServerUpdateFirst(t *testing.T) {
+	udpServer := startTestUDPServer(t, make(chan []byte, 1))
+	defer udpServer.Close()
+
+	identified := make(chan GatewayMessageDataIdentify, 1)
+	gatewayServer := startTestGatewayServer(t, udpServer.LocalAddr().(*net.UDPAddr), identified)
+	defer gatewayServer.Close()
+
+	var conn Conn
+	stateUpdateFunc := func(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error {
+		if channelID == nil {
+			return nil
+		}
+		endpoint := strings.Replace(gatewayServer.URL, "http://", "ws://", 1)
+		go func() {
+			conn.HandleVoiceServerUpdate(gateway.EventVoiceServerUpdate{
+				Token:    "token",
+				GuildID:  guildID,
+				Endpoint: &endpoint,
+			})
+			// give the voice gateway the chance to open without a session id
+			time.Sleep(100 * time.Millisecond)
+			conn.HandleVoiceStateUpdate(gateway.EventVoiceStateUpdate{VoiceState: discord.VoiceState{
+				GuildID:   guildID,
+				ChannelID: channelID,
+				UserID:    testUserID,
+				SessionID: "session",
+			}})
+		}()
+		return nil
+	}
+
+	conn = NewConn(testGuildID, testUserID, stateUpdateFunc, nil, WithConnLogger(log.New(log.LstdFlags)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, conn.Open(ctx, testChannelID, false, false))
+	defer conn.Close(ctx)
+
+	identify := <-identified
+	assert.Equal(t, "session", identify.SessionID)
+	assert.Equal(t, "token", identify.Token)
+}
@@ -0,0 +1,91 @@
+package voice
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// GatewayVersion defines which discord voice gateway version disgo should use to connect to discord.
+const GatewayVersion = 4
+
+// GatewayStatus is the state that the voice Gateway is currently in.
+type GatewayStatus int
+
+// Indicates how far along the voice Gateway is to connecting.
+const (
+	// GatewayStatusUnconnected is the initial state when a new Gateway is created.
+	GatewayStatusUnconnected GatewayStatus = iota
+
+	// GatewayStatusConnecting is the state when the Gateway is connecting to the voice gateway.
+	GatewayStatusConnecting
+
+	// GatewayStatusWaitingForHello is the state when the Gateway is waiting for the first OpcodeHello packet.
+	GatewayStatusWaitingForHello
+
+	// GatewayStatusIdentifying is the state when the Gateway received its first OpcodeHello packet and now sends a OpcodeIdentify packet.
+	GatewayStatusIdentifying
+
+	// GatewayStatusResuming is the state when the Gateway received its first OpcodeHello packet and now sends a OpcodeResume packet.
+	GatewayStatusResuming
+
+	// GatewayStatusWaitingForReady is the state when the Gateway sent a OpcodeIdentify packet and now waits for a OpcodeReady packet.
+	GatewayStatusWaitingForReady
+
+	// GatewayStatusReady is the state when the Gateway received a OpcodeReady or OpcodeResumed packet.
+	GatewayStatusReady
+
+	// GatewayStatusDisconnected is the state when the Gateway is disconnected.
+	// Either due to an error or because the Gateway was closed gracefully.
+	GatewayStatusDisconnected
+)
+
+type (
+	// GatewayEventHandlerFunc is a function that is called when a message is received by the Gateway.
+	GatewayEventHandlerFunc func(opCode Opcode, data GatewayMessageData)
+
+	// GatewayCloseHandlerFunc is a function that is called when the Gateway is closed and won't reconnect.
+	GatewayCloseHandlerFunc func(gateway Gateway, err error)
+
+	// GatewayCreateFunc is a type that is used to create a new Gateway.
+	GatewayCreateFunc func(eventHandlerFunc GatewayEventHandlerFunc, closeHandlerFunc GatewayCloseHandlerFunc, opts ...GatewayConfigOpt) Gateway
+)
+
+// State is the data needed to connect to the voice gateway.
+// It is assembled from the gateway.EventVoiceStateUpdate & gateway.EventVoiceServerUpdate received on the main gateway.
+type State struct {
+	GuildID   snowflake.ID
+	UserID    snowflake.ID
+	ChannelID *snowflake.ID
+
+	SessionID string
+	Token     string
+	Endpoint  string
+}
+
+// Gateway is what is used to connect to the discord voice gateway.
+type Gateway interface {
+	// SSRC returns the ssrc assigned by Discord in the GatewayMessageDataReady.
+	SSRC() uint32
+
+	// Latency returns the latency of the Gateway.
+	// This is calculated by the time it takes to send a heartbeat and receive a heartbeat ack by discord.
+	Latency() time.Duration
+
+	// Status returns the GatewayStatus of the Gateway.
+	Status() GatewayStatus
+
+	// Open connects this Gateway to the voice server in the given State.
+	Open(ctx context.Context, state State) error
+
+	// Close gracefully closes the Gateway with the websocket.CloseNormalClosure code.
+	Close()
+
+	// CloseWithCode closes the Gateway with the given code & message.
+	CloseWithCode(code int, message string)
+
+	// Send sends a message to the voice gateway with the opCode and data.
+	// If context is deadline exceeds, the message sending will be aborted.
+	Send(ctx context.Context, opCode Opcode, data GatewayMessageData) error
+}
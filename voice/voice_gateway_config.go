@@ -0,0 +1,62 @@
+package voice
+
+import (
+	"github.com/disgoorg/log"
+	"github.com/gorilla/websocket"
+)
+
+// DefaultGatewayConfig returns a GatewayConfig with sensible defaults.
+func DefaultGatewayConfig() *GatewayConfig {
+	return &GatewayConfig{
+		Logger:            log.Default(),
+		Dialer:            websocket.DefaultDialer,
+		AutoReconnect:     true,
+		MaxReconnectTries: 10,
+	}
+}
+
+// GatewayConfig lets you configure your voice Gateway instance.
+type GatewayConfig struct {
+	Logger            log.Logger
+	Dialer            *websocket.Dialer
+	AutoReconnect     bool
+	MaxReconnectTries int
+}
+
+// GatewayConfigOpt is a type alias for a function that takes a GatewayConfig and is used to configure your voice Gateway.
+type GatewayConfigOpt func(config *GatewayConfig)
+
+// Apply applies the given GatewayConfigOpt(s) to the GatewayConfig
+func (c *GatewayConfig) Apply(opts []GatewayConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithGatewayLogger sets the Logger for the voice Gateway.
+func WithGatewayLogger(logger log.Logger) GatewayConfigOpt {
+	return func(config *GatewayConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithGatewayDialer sets the websocket.Dialer for the voice Gateway.
+func WithGatewayDialer(dialer *websocket.Dialer) GatewayConfigOpt {
+	return func(config *GatewayConfig) {
+		config.Dialer = dialer
+	}
+}
+
+// WithGatewayAutoReconnect sets whether the voice Gateway should automatically resume the session when the connection drops.
+func WithGatewayAutoReconnect(autoReconnect bool) GatewayConfigOpt {
+	return func(config *GatewayConfig) {
+		config.AutoReconnect = autoReconnect
+	}
+}
+
+// WithGatewayMaxReconnectTries sets the maximum number of reconnect attempts before stopping.
+func WithGatewayMaxReconnectTries(maxReconnectTries int) GatewayConfigOpt {
+	return func(config *GatewayConfig) {
+		config.MaxReconnectTries = maxReconnectTries
+	}
+}
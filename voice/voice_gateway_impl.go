@@ -0,0 +1,372 @@
+package voice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/gorilla/websocket"
+)
+
+var (
+	// ErrGatewayNotConnected is returned when trying to send a message to a voice Gateway which is not connected.
+	ErrGatewayNotConnected = errors.New("voice gateway not connected")
+
+	// ErrGatewayAlreadyConnected is returned when trying to open a voice Gateway which is already connected.
+	ErrGatewayAlreadyConnected = errors.New("voice gateway already connected")
+)
+
+var _ Gateway = (*gatewayImpl)(nil)
+
+// NewGateway creates a new voice Gateway instance with the provided eventHandlerFunc, closeHandlerFunc and GatewayConfigOpt(s).
+func NewGateway(eventHandlerFunc GatewayEventHandlerFunc, closeHandlerFunc GatewayCloseHandlerFunc, opts ...GatewayConfigOpt) Gateway {
+	config := DefaultGatewayConfig()
+	config.Apply(opts)
+
+	return &gatewayImpl{
+		config:           *config,
+		eventHandlerFunc: eventHandlerFunc,
+		closeHandlerFunc: closeHandlerFunc,
+		status:           GatewayStatusUnconnected,
+	}
+}
+
+type gatewayImpl struct {
+	config           GatewayConfig
+	eventHandlerFunc GatewayEventHandlerFunc
+	closeHandlerFunc GatewayCloseHandlerFunc
+
+	state State
+	ssrc  uint32
+
+	conn            *websocket.Conn
+	connMu          sync.Mutex
+	heartbeatCancel context.CancelFunc
+	status          GatewayStatus
+
+	heartbeatInterval     time.Duration
+	lastHeartbeatSent     time.Time
+	lastHeartbeatReceived time.Time
+	lastNonce             int64
+}
+
+func (g *gatewayImpl) SSRC() uint32 {
+	return g.ssrc
+}
+
+func (g *gatewayImpl) Latency() time.Duration {
+	return g.lastHeartbeatReceived.Sub(g.lastHeartbeatSent)
+}
+
+func (g *gatewayImpl) Status() GatewayStatus {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	return g.status
+}
+
+func (g *gatewayImpl) setStatus(status GatewayStatus) {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	g.status = status
+}
+
+func (g *gatewayImpl) formatLogsf(format string, a ...any) string {
+	return fmt.Sprintf("[%s] %s", g.state.GuildID, fmt.Sprintf(format, a...))
+}
+
+func (g *gatewayImpl) formatLogs(a ...any) string {
+	return fmt.Sprintf("[%s] %s", g.state.GuildID, fmt.Sprint(a...))
+}
+
+func (g *gatewayImpl) Open(ctx context.Context, state State) error {
+	g.config.Logger.Debug(g.formatLogs("opening voice gateway connection"))
+
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	if g.conn != nil {
+		return ErrGatewayAlreadyConnected
+	}
+	g.status = GatewayStatusConnecting
+	g.state = state
+
+	endpoint := strings.TrimSuffix(state.Endpoint, ":80")
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "wss://" + endpoint
+	}
+	gatewayURL := fmt.Sprintf("%s?v=%d", endpoint, GatewayVersion)
+
+	g.lastHeartbeatSent = time.Now().UTC()
+	conn, _, err := g.config.Dialer.DialContext(ctx, gatewayURL, nil)
+	if err != nil {
+		g.status = GatewayStatusDisconnected
+		g.config.Logger.Error(g.formatLogsf("error connecting to the voice gateway. url: %s, error: %s", gatewayURL, err))
+		return err
+	}
+
+	conn.SetCloseHandler(func(code int, text string) error {
+		return nil
+	})
+
+	g.conn = conn
+	g.status = GatewayStatusWaitingForHello
+
+	go g.listen(conn)
+
+	return nil
+}
+
+func (g *gatewayImpl) Close() {
+	g.CloseWithCode(websocket.CloseNormalClosure, "Shutting down")
+}
+
+func (g *gatewayImpl) CloseWithCode(code int, message string) {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	if g.heartbeatCancel != nil {
+		g.config.Logger.Debug(g.formatLogs("closing heartbeat goroutines..."))
+		g.heartbeatCancel()
+		g.heartbeatCancel = nil
+	}
+	if g.conn != nil {
+		g.config.Logger.Debug(g.formatLogsf("closing voice gateway connection with code: %d, message: %s", code, message))
+		if err := g.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, message)); err != nil && err != websocket.ErrCloseSent {
+			g.config.Logger.Debug(g.formatLogs("error writing close code. error: ", err))
+		}
+		_ = g.conn.Close()
+		g.conn = nil
+		g.status = GatewayStatusDisconnected
+
+		// clear resume data as we closed gracefully
+		if code == websocket.CloseNormalClosure || code == websocket.CloseGoingAway {
+			g.ssrc = 0
+		}
+	}
+}
+
+func (g *gatewayImpl) Send(ctx context.Context, opCode Opcode, data GatewayMessageData) error {
+	rawData, err := json.Marshal(GatewayMessage{
+		Op: opCode,
+		D:  data,
+	})
+	if err != nil {
+		return err
+	}
+	return g.send(ctx, websocket.TextMessage, rawData)
+}
+
+func (g *gatewayImpl) send(ctx context.Context, messageType int, data []byte) error {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	if g.conn == nil {
+		return ErrGatewayNotConnected
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = g.conn.SetWriteDeadline(deadline)
+		defer g.conn.SetWriteDeadline(time.Time{})
+	}
+
+	g.config.Logger.Trace(g.formatLogs("sending voice gateway command: ", string(data)))
+	return g.conn.WriteMessage(messageType, data)
+}
+
+func (g *gatewayImpl) reconnectTry(ctx context.Context, try int, delay time.Duration) error {
+	if try >= g.config.MaxReconnectTries-1 {
+		return fmt.Errorf("failed to reconnect. exceeded max reconnect tries of %d reached", g.config.MaxReconnectTries)
+	}
+	timer := time.NewTimer(time.Duration(try) * delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	g.config.Logger.Debug(g.formatLogs("reconnecting voice gateway..."))
+	if err := g.Open(ctx, g.state); err != nil {
+		if err == ErrGatewayAlreadyConnected {
+			return err
+		}
+		g.config.Logger.Error(g.formatLogs("failed to reconnect voice gateway. error: ", err))
+		return g.reconnectTry(ctx, try+1, delay)
+	}
+	return nil
+}
+
+func (g *gatewayImpl) reconnect(ctx context.Context) {
+	if err := g.reconnectTry(ctx, 0, time.Second); err != nil {
+		g.config.Logger.Error(g.formatLogs("failed to reopen voice gateway. error: ", err))
+		if g.closeHandlerFunc != nil {
+			g.closeHandlerFunc(g, err)
+		}
+	}
+}
+
+func (g *gatewayImpl) startHeartbeat(interval time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.connMu.Lock()
+	if g.heartbeatCancel != nil {
+		g.heartbeatCancel()
+	}
+	g.heartbeatCancel = cancel
+	g.heartbeatInterval = interval
+	g.connMu.Unlock()
+
+	go g.heartbeat(ctx, interval)
+}
+
+func (g *gatewayImpl) heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer g.config.Logger.Debug(g.formatLogs("exiting heartbeat goroutine..."))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.sendHeartbeat(interval)
+		}
+	}
+}
+
+func (g *gatewayImpl) sendHeartbeat(timeout time.Duration) {
+	g.config.Logger.Debug(g.formatLogs("sending heartbeat..."))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	g.lastNonce = time.Now().UnixMilli()
+	if err := g.Send(ctx, OpcodeHeartbeat, GatewayMessageDataHeartbeat(g.lastNonce)); err != nil {
+		if err == ErrGatewayNotConnected || errors.Is(err, syscall.EPIPE) {
+			return
+		}
+		g.config.Logger.Error(g.formatLogs("failed to send heartbeat. error: ", err))
+		g.CloseWithCode(websocket.CloseServiceRestart, "heartbeat timeout")
+		go g.reconnect(context.TODO())
+		return
+	}
+	g.lastHeartbeatSent = time.Now().UTC()
+}
+
+func (g *gatewayImpl) identify() {
+	g.setStatus(GatewayStatusIdentifying)
+	g.config.Logger.Debug(g.formatLogs("sending Identify command..."))
+
+	identify := GatewayMessageDataIdentify{
+		GuildID:   g.state.GuildID,
+		UserID:    g.state.UserID,
+		SessionID: g.state.SessionID,
+		Token:     g.state.Token,
+	}
+	if err := g.Send(context.TODO(), OpcodeIdentify, identify); err != nil {
+		g.config.Logger.Error(g.formatLogs("error sending Identify command err: ", err))
+	}
+	g.setStatus(GatewayStatusWaitingForReady)
+}
+
+func (g *gatewayImpl) resume() {
+	g.setStatus(GatewayStatusResuming)
+	resume := GatewayMessageDataResume{
+		GuildID:   g.state.GuildID,
+		SessionID: g.state.SessionID,
+		Token:     g.state.Token,
+	}
+
+	g.config.Logger.Debug(g.formatLogs("sending Resume command..."))
+	if err := g.Send(context.TODO(), OpcodeResume, resume); err != nil {
+		g.config.Logger.Error(g.formatLogs("error sending Resume command err: ", err))
+	}
+}
+
+func (g *gatewayImpl) listen(conn *websocket.Conn) {
+	defer g.config.Logger.Debug(g.formatLogs("exiting listen goroutine..."))
+loop:
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			g.connMu.Lock()
+			sameConnection := g.conn == conn
+			g.connMu.Unlock()
+
+			// if sameConnection is false, it means the connection has been closed by the user, and we can just exit
+			if !sameConnection {
+				return
+			}
+
+			reconnect := true
+			if closeError, ok := err.(*websocket.CloseError); ok {
+				closeCode := GatewayCloseEventCode(closeError.Code)
+				reconnect = closeCode.ShouldResume()
+				message := g.formatLogsf("voice gateway close received, reconnect: %t, code: %d, error: %s", g.config.AutoReconnect && reconnect, closeError.Code, closeError.Text)
+				if reconnect {
+					g.config.Logger.Debug(message)
+				} else {
+					g.config.Logger.Error(message)
+				}
+			} else if errors.Is(err, net.ErrClosed) {
+				// we closed the connection ourselves. Don't try to reconnect here
+				reconnect = false
+			} else {
+				g.config.Logger.Debug(g.formatLogs("failed to read next message from voice gateway. error: ", err))
+			}
+
+			// make sure the connection is properly closed
+			g.CloseWithCode(websocket.CloseServiceRestart, "reconnecting")
+			if g.config.AutoReconnect && reconnect {
+				go g.reconnect(context.TODO())
+			} else if g.closeHandlerFunc != nil {
+				go g.closeHandlerFunc(g, err)
+			}
+			break loop
+		}
+
+		g.config.Logger.Trace(g.formatLogs("received voice gateway message: ", string(data)))
+
+		var message GatewayMessage
+		if err = json.Unmarshal(data, &message); err != nil {
+			g.config.Logger.Error(g.formatLogs("error while parsing voice gateway message. error: ", err))
+			continue
+		}
+
+		switch d := message.D.(type) {
+		case GatewayMessageDataHello:
+			g.lastHeartbeatReceived = time.Now().UTC()
+			g.startHeartbeat(time.Duration(d.HeartbeatInterval) * time.Millisecond)
+
+			if g.ssrc == 0 {
+				g.identify()
+			} else {
+				g.resume()
+			}
+
+		case GatewayMessageDataReady:
+			g.ssrc = d.SSRC
+			g.setStatus(GatewayStatusReady)
+			g.config.Logger.Debug(g.formatLogs("ready message received"))
+
+		case GatewayMessageDataHeartbeat:
+			g.sendHeartbeat(g.heartbeatInterval)
+
+		case GatewayMessageDataHeartbeatACK:
+			if int64(d) != g.lastNonce {
+				g.config.Logger.Debug(g.formatLogsf("received heartbeat ack with unexpected nonce. expected: %d, received: %d", g.lastNonce, d))
+			}
+			g.lastHeartbeatReceived = time.Now().UTC()
+		}
+
+		if message.Op == OpcodeResumed {
+			g.setStatus(GatewayStatusReady)
+			g.config.Logger.Debug(g.formatLogs("resumed message received"))
+		}
+
+		if g.eventHandlerFunc != nil {
+			g.eventHandlerFunc(message.Op, message.D)
+		}
+	}
+}
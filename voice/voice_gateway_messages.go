@@ -0,0 +1,228 @@
+package voice
+
+import (
+	"fmt"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// GatewayMessage raw voice gateway message type
+type GatewayMessage struct {
+	Op Opcode             `json:"op"`
+	D  GatewayMessageData `json:"d,omitempty"`
+}
+
+func (m *GatewayMessage) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Op Opcode          `json:"op"`
+		D  json.RawMessage `json:"d,omitempty"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var (
+		messageData GatewayMessageData
+		err         error
+	)
+
+	switch v.Op {
+	case OpcodeIdentify:
+		var d GatewayMessageDataIdentify
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeSelectProtocol:
+		var d GatewayMessageDataSelectProtocol
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeReady:
+		var d GatewayMessageDataReady
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeHeartbeat:
+		var d GatewayMessageDataHeartbeat
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeSessionDescription:
+		var d GatewayMessageDataSessionDescription
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeSpeaking:
+		var d GatewayMessageDataSpeaking
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeHeartbeatACK:
+		var d GatewayMessageDataHeartbeatACK
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeResume:
+		var d GatewayMessageDataResume
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeHello:
+		var d GatewayMessageDataHello
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeResumed:
+
+	case OpcodeClientConnect:
+		var d GatewayMessageDataClientConnect
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	case OpcodeClientDisconnect:
+		var d GatewayMessageDataClientDisconnect
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
+	default:
+		messageData = GatewayMessageDataUnknown(v.D)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal voice gateway message data of opcode %d: %w", v.Op, err)
+	}
+	m.Op = v.Op
+	m.D = messageData
+	return nil
+}
+
+// GatewayMessageData is the data of a GatewayMessage.
+type GatewayMessageData interface {
+	voiceGatewayMessageData()
+}
+
+// GatewayMessageDataIdentify is sent to identify with the voice gateway.
+type GatewayMessageDataIdentify struct {
+	GuildID   snowflake.ID `json:"server_id"`
+	UserID    snowflake.ID `json:"user_id"`
+	SessionID string       `json:"session_id"`
+	Token     string       `json:"token"`
+}
+
+func (GatewayMessageDataIdentify) voiceGatewayMessageData() {}
+
+// GatewayMessageDataSelectProtocol is sent to tell the voice gateway which address and encryption mode to use.
+type GatewayMessageDataSelectProtocol struct {
+	Protocol Protocol                             `json:"protocol"`
+	Data     GatewayMessageDataSelectProtocolData `json:"data"`
+}
+
+func (GatewayMessageDataSelectProtocol) voiceGatewayMessageData() {}
+
+// GatewayMessageDataSelectProtocolData is the external address & port discovered via IP discovery and the selected EncryptionMode.
+type GatewayMessageDataSelectProtocolData struct {
+	Address string         `json:"address"`
+	Port    int            `json:"port"`
+	Mode    EncryptionMode `json:"mode"`
+}
+
+// GatewayMessageDataReady is received once the voice gateway accepted our GatewayMessageDataIdentify.
+type GatewayMessageDataReady struct {
+	SSRC  uint32           `json:"ssrc"`
+	IP    string           `json:"ip"`
+	Port  int              `json:"port"`
+	Modes []EncryptionMode `json:"modes"`
+}
+
+func (GatewayMessageDataReady) voiceGatewayMessageData() {}
+
+// GatewayMessageDataHeartbeat is the nonce sent with each heartbeat.
+type GatewayMessageDataHeartbeat int64
+
+func (GatewayMessageDataHeartbeat) voiceGatewayMessageData() {}
+
+// GatewayMessageDataSessionDescription contains the secret key used to encrypt & decrypt voice packets.
+type GatewayMessageDataSessionDescription struct {
+	Mode      EncryptionMode `json:"mode"`
+	SecretKey [32]byte       `json:"secret_key"`
+}
+
+func (GatewayMessageDataSessionDescription) voiceGatewayMessageData() {}
+
+// GatewayMessageDataSpeaking is sent & received to indicate which ssrc is sending audio.
+type GatewayMessageDataSpeaking struct {
+	Speaking SpeakingFlags `json:"speaking"`
+	Delay    int           `json:"delay"`
+	SSRC     uint32        `json:"ssrc"`
+	UserID   snowflake.ID  `json:"user_id,omitempty"`
+}
+
+func (GatewayMessageDataSpeaking) voiceGatewayMessageData() {}
+
+// GatewayMessageDataHeartbeatACK is the nonce of the heartbeat Discord acknowledged.
+type GatewayMessageDataHeartbeatACK int64
+
+func (GatewayMessageDataHeartbeatACK) voiceGatewayMessageData() {}
+
+// GatewayMessageDataResume is sent to resume a voice gateway session.
+type GatewayMessageDataResume struct {
+	GuildID   snowflake.ID `json:"server_id"`
+	SessionID string       `json:"session_id"`
+	Token     string       `json:"token"`
+}
+
+func (GatewayMessageDataResume) voiceGatewayMessageData() {}
+
+// GatewayMessageDataHello is received when connecting to the voice gateway.
+type GatewayMessageDataHello struct {
+	HeartbeatInterval float64 `json:"heartbeat_interval"`
+}
+
+func (GatewayMessageDataHello) voiceGatewayMessageData() {}
+
+// GatewayMessageDataClientConnect is received when a user connects to the voice channel.
+type GatewayMessageDataClientConnect struct {
+	UserID    snowflake.ID `json:"user_id"`
+	AudioSSRC uint32       `json:"audio_ssrc"`
+	VideoSSRC uint32       `json:"video_ssrc"`
+}
+
+func (GatewayMessageDataClientConnect) voiceGatewayMessageData() {}
+
+// GatewayMessageDataClientDisconnect is received when a user disconnects from the voice channel.
+type GatewayMessageDataClientDisconnect struct {
+	UserID snowflake.ID `json:"user_id"`
+}
+
+func (GatewayMessageDataClientDisconnect) voiceGatewayMessageData() {}
+
+// GatewayMessageDataUnknown is used for opcodes disgo does not know about.
+type GatewayMessageDataUnknown json.RawMessage
+
+func (GatewayMessageDataUnknown) voiceGatewayMessageData() {}
+
+// Protocol is the transport protocol used for sending voice data.
+type Protocol string
+
+const (
+	ProtocolUDP Protocol = "udp"
+)
+
+// EncryptionMode is the encryption mode used for voice packets.
+type EncryptionMode string
+
+const (
+	EncryptionModeNormal EncryptionMode = "xsalsa20_poly1305"
+	EncryptionModeSuffix EncryptionMode = "xsalsa20_poly1305_suffix"
+	EncryptionModeLite   EncryptionMode = "xsalsa20_poly1305_lite"
+)
+
+// SpeakingFlags are the flags sent with GatewayMessageDataSpeaking.
+type SpeakingFlags int
+
+const (
+	SpeakingFlagMicrophone SpeakingFlags = 1 << iota
+	SpeakingFlagSoundshare
+	SpeakingFlagPriority
+	SpeakingFlagNone SpeakingFlags = 0
+)
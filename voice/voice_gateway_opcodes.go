@@ -0,0 +1,59 @@
+package voice
+
+// Opcode are opcodes used by the Discord voice gateway
+type Opcode int
+
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#voice
+const (
+	OpcodeIdentify Opcode = iota
+	OpcodeSelectProtocol
+	OpcodeReady
+	OpcodeHeartbeat
+	OpcodeSessionDescription
+	OpcodeSpeaking
+	OpcodeHeartbeatACK
+	OpcodeResume
+	OpcodeHello
+	OpcodeResumed
+	_
+	_
+	OpcodeClientConnect
+	OpcodeClientDisconnect
+)
+
+type GatewayCloseEventCode int
+
+const (
+	GatewayCloseEventCodeUnknownOpcode GatewayCloseEventCode = iota + 4001
+	GatewayCloseEventCodeFailedDecode
+	GatewayCloseEventCodeNotAuthenticated
+	GatewayCloseEventCodeAuthenticationFailed
+	GatewayCloseEventCodeAlreadyAuthenticated
+	GatewayCloseEventCodeSessionNoLongerValid
+	_
+	_
+	GatewayCloseEventCodeSessionTimeout
+	_
+	GatewayCloseEventCodeServerNotFound
+	GatewayCloseEventCodeUnknownProtocol
+	_
+	GatewayCloseEventCodeDisconnected
+	GatewayCloseEventCodeVoiceServerCrash
+	GatewayCloseEventCodeUnknownEncryptionMode
+)
+
+// ShouldResume returns whether the voice session can be resumed after receiving this GatewayCloseEventCode.
+func (c GatewayCloseEventCode) ShouldResume() bool {
+	switch c {
+	case GatewayCloseEventCodeAuthenticationFailed,
+		GatewayCloseEventCodeSessionNoLongerValid,
+		GatewayCloseEventCodeServerNotFound,
+		GatewayCloseEventCodeUnknownProtocol,
+		GatewayCloseEventCodeDisconnected,
+		GatewayCloseEventCodeUnknownEncryptionMode:
+		return false
+
+	default:
+		return true
+	}
+}
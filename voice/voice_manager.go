@@ -0,0 +1,119 @@
+package voice
+
+import (
+	"context"
+	"sync"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Manager manages all voice Conn(s) of a bot.
+// It receives the gateway.EventVoiceStateUpdate & gateway.EventVoiceServerUpdate from the main gateway and passes them to the matching Conn.
+type Manager interface {
+	// HandleVoiceStateUpdate passes the gateway.EventVoiceStateUpdate to the Conn of the guild if the update is about the bot user.
+	HandleVoiceStateUpdate(update gateway.EventVoiceStateUpdate)
+
+	// HandleVoiceServerUpdate passes the gateway.EventVoiceServerUpdate to the Conn of the guild.
+	HandleVoiceServerUpdate(update gateway.EventVoiceServerUpdate)
+
+	// CreateConn creates a new Conn for the given guild. If a Conn already exists it is returned instead.
+	CreateConn(guildID snowflake.ID) Conn
+
+	// GetConn returns the Conn of the given guild or nil if none exists.
+	GetConn(guildID snowflake.ID) Conn
+
+	// ForEachConn calls the given function for each Conn.
+	ForEachConn(f func(conn Conn))
+
+	// RemoveConn removes the Conn of the given guild without closing it.
+	RemoveConn(guildID snowflake.ID)
+
+	// Close closes all Conn(s).
+	Close(ctx context.Context)
+}
+
+var _ Manager = (*managerImpl)(nil)
+
+// NewManager creates a new Manager for the given bot user, using the StateUpdateFunc to join & leave voice channels.
+func NewManager(stateUpdateFunc StateUpdateFunc, userID snowflake.ID, opts ...ManagerConfigOpt) Manager {
+	config := DefaultManagerConfig()
+	config.Apply(opts)
+
+	return &managerImpl{
+		config:          *config,
+		stateUpdateFunc: stateUpdateFunc,
+		userID:          userID,
+		conns:           map[snowflake.ID]Conn{},
+	}
+}
+
+type managerImpl struct {
+	config          ManagerConfig
+	stateUpdateFunc StateUpdateFunc
+	userID          snowflake.ID
+
+	conns   map[snowflake.ID]Conn
+	connsMu sync.Mutex
+}
+
+func (m *managerImpl) HandleVoiceStateUpdate(update gateway.EventVoiceStateUpdate) {
+	if update.UserID != m.userID {
+		return
+	}
+	if conn := m.GetConn(update.GuildID); conn != nil {
+		conn.HandleVoiceStateUpdate(update)
+	}
+}
+
+func (m *managerImpl) HandleVoiceServerUpdate(update gateway.EventVoiceServerUpdate) {
+	if conn := m.GetConn(update.GuildID); conn != nil {
+		conn.HandleVoiceServerUpdate(update)
+	}
+}
+
+func (m *managerImpl) CreateConn(guildID snowflake.ID) Conn {
+	m.connsMu.Lock()
+	defer m.connsMu.Unlock()
+	if conn, ok := m.conns[guildID]; ok {
+		return conn
+	}
+
+	m.config.Logger.Debugf("creating new voice connection for guild: %s", guildID)
+	conn := m.config.ConnCreateFunc(guildID, m.userID, m.stateUpdateFunc, func() {
+		m.RemoveConn(guildID)
+	}, append([]ConnConfigOpt{WithConnLogger(m.config.Logger)}, m.config.ConnConfigOpts...)...)
+	m.conns[guildID] = conn
+	return conn
+}
+
+func (m *managerImpl) GetConn(guildID snowflake.ID) Conn {
+	m.connsMu.Lock()
+	defer m.connsMu.Unlock()
+	return m.conns[guildID]
+}
+
+func (m *managerImpl) ForEachConn(f func(conn Conn)) {
+	m.connsMu.Lock()
+	conns := make([]Conn, 0, len(m.conns))
+	for _, conn := range m.conns {
+		conns = append(conns, conn)
+	}
+	m.connsMu.Unlock()
+
+	for _, conn := range conns {
+		f(conn)
+	}
+}
+
+func (m *managerImpl) RemoveConn(guildID snowflake.ID) {
+	m.connsMu.Lock()
+	defer m.connsMu.Unlock()
+	delete(m.conns, guildID)
+}
+
+func (m *managerImpl) Close(ctx context.Context) {
+	m.ForEachConn(func(conn Conn) {
+		conn.Close(ctx)
+	})
+}
@@ -0,0 +1,52 @@
+package voice
+
+import (
+	"github.com/disgoorg/log"
+)
+
+// DefaultManagerConfig returns a ManagerConfig with sensible defaults.
+func DefaultManagerConfig() *ManagerConfig {
+	return &ManagerConfig{
+		Logger:         log.Default(),
+		ConnCreateFunc: NewConn,
+	}
+}
+
+// ManagerConfig lets you configure your Manager instance.
+type ManagerConfig struct {
+	Logger log.Logger
+
+	ConnCreateFunc ConnCreateFunc
+	ConnConfigOpts []ConnConfigOpt
+}
+
+// ManagerConfigOpt is a type alias for a function that takes a ManagerConfig and is used to configure your Manager.
+type ManagerConfigOpt func(config *ManagerConfig)
+
+// Apply applies the given ManagerConfigOpt(s) to the ManagerConfig
+func (c *ManagerConfig) Apply(opts []ManagerConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithLogger sets the Logger for the Manager.
+func WithLogger(logger log.Logger) ManagerConfigOpt {
+	return func(config *ManagerConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithConnCreateFunc sets the ConnCreateFunc for the Manager.
+func WithConnCreateFunc(connCreateFunc ConnCreateFunc) ManagerConfigOpt {
+	return func(config *ManagerConfig) {
+		config.ConnCreateFunc = connCreateFunc
+	}
+}
+
+// WithConnConfigOpts lets you configure the Conn(s) created by the Manager.
+func WithConnConfigOpts(opts ...ConnConfigOpt) ManagerConfigOpt {
+	return func(config *ManagerConfig) {
+		config.ConnConfigOpts = append(config.ConnConfigOpts, opts...)
+	}
+}
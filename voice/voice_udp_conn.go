@@ -0,0 +1,328 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	// OpusFrameSize is the number of samples per channel in a 20ms opus frame at 48kHz.
+	OpusFrameSize = 960
+
+	// OpusFrameDuration is the duration of a single opus frame.
+	OpusFrameDuration = 20 * time.Millisecond
+
+	// OpusPacketType is the rtp payload type discord uses for opus audio.
+	OpusPacketType = 0x78
+
+	// RTPHeaderSize is the size of the rtp header prepended to each voice packet.
+	RTPHeaderSize = 12
+
+	// RTPVersion is the first byte of the rtp header with the version bits set.
+	RTPVersion = 0x80
+
+	ipDiscoveryPacketSize         = 74
+	ipDiscoveryPacketTypeRequest  = 0x1
+	ipDiscoveryPacketTypeResponse = 0x2
+	ipDiscoveryPacketLength       = 70
+)
+
+var (
+	// SilenceAudioFrame is a 20ms opus frame of silence. It should be sent 5 times after you stopped sending audio to avoid interpolation.
+	SilenceAudioFrame = []byte{0xF8, 0xFF, 0xFE}
+
+	// ErrUDPConnNotOpen is returned when trying to read or write on a UDPConn which has not been opened.
+	ErrUDPConnNotOpen = errors.New("voice udp connection not open")
+
+	// ErrNoSecretKey is returned when trying to read or write on a UDPConn which has not received its secret key yet.
+	ErrNoSecretKey = errors.New("voice udp connection has no secret key")
+
+	// ErrDecryptionFailed is returned when a received packet could not be decrypted.
+	ErrDecryptionFailed = errors.New("failed to decrypt voice packet")
+)
+
+type (
+	// UDPConnCreateFunc is a type that is used to create a new UDPConn.
+	UDPConnCreateFunc func(opts ...UDPConnConfigOpt) UDPConn
+
+	// Packet is a decrypted rtp packet received from the voice server.
+	Packet struct {
+		Type      byte
+		Sequence  uint16
+		Timestamp uint32
+		SSRC      uint32
+		Opus      []byte
+	}
+)
+
+// UDPConn represents the UDP connection to a Discord voice server which is used to send & receive opus frames.
+type UDPConn interface {
+	// LocalAddr returns the local network address, if known.
+	LocalAddr() net.Addr
+
+	// RemoteAddr returns the remote network address, if known.
+	RemoteAddr() net.Addr
+
+	// SetSecretKey sets the secret key used to encrypt & decrypt voice packets.
+	SetSecretKey(secretKey [32]byte)
+
+	// SetDeadline sets the read and write deadlines associated with the connection.
+	SetDeadline(t time.Time) error
+
+	// SetReadDeadline sets the deadline for future ReadPacket calls.
+	SetReadDeadline(t time.Time) error
+
+	// SetWriteDeadline sets the deadline for future Write calls.
+	SetWriteDeadline(t time.Time) error
+
+	// Open dials the voice server at the given ip & port and performs IP discovery.
+	// It returns the external address & port which need to be sent to the voice gateway with OpcodeSelectProtocol.
+	Open(ctx context.Context, ip string, port int, ssrc uint32) (string, int, error)
+
+	// Close closes the UDPConn.
+	Close() error
+
+	// ReadPacket reads & decrypts the next rtp Packet from the voice server.
+	ReadPacket() (*Packet, error)
+
+	// Write encrypts & sends a single opus frame to the voice server.
+	Write(p []byte) (int, error)
+}
+
+var _ UDPConn = (*udpConnImpl)(nil)
+
+// NewUDPConn creates a new UDPConn with the provided UDPConnConfigOpt(s).
+func NewUDPConn(opts ...UDPConnConfigOpt) UDPConn {
+	config := DefaultUDPConnConfig()
+	config.Apply(opts)
+
+	return &udpConnImpl{
+		config:     *config,
+		packetBuff: make([]byte, RTPHeaderSize),
+		receiveBuf: make([]byte, 1400),
+	}
+}
+
+type udpConnImpl struct {
+	config UDPConnConfig
+
+	conn   net.Conn
+	connMu sync.Mutex
+
+	secretKey   *[32]byte
+	secretKeyMu sync.RWMutex
+
+	ssrc       uint32
+	sequence   uint16
+	timestamp  uint32
+	packetBuff []byte
+	writeMu    sync.Mutex
+
+	receiveBuf []byte
+	nonce      [24]byte
+}
+
+func (u *udpConnImpl) LocalAddr() net.Addr {
+	u.connMu.Lock()
+	defer u.connMu.Unlock()
+	if u.conn == nil {
+		return nil
+	}
+	return u.conn.LocalAddr()
+}
+
+func (u *udpConnImpl) RemoteAddr() net.Addr {
+	u.connMu.Lock()
+	defer u.connMu.Unlock()
+	if u.conn == nil {
+		return nil
+	}
+	return u.conn.RemoteAddr()
+}
+
+func (u *udpConnImpl) SetSecretKey(secretKey [32]byte) {
+	u.secretKeyMu.Lock()
+	defer u.secretKeyMu.Unlock()
+	u.secretKey = &secretKey
+}
+
+func (u *udpConnImpl) getSecretKey() *[32]byte {
+	u.secretKeyMu.RLock()
+	defer u.secretKeyMu.RUnlock()
+	return u.secretKey
+}
+
+func (u *udpConnImpl) getConn() net.Conn {
+	u.connMu.Lock()
+	defer u.connMu.Unlock()
+	return u.conn
+}
+
+func (u *udpConnImpl) SetDeadline(t time.Time) error {
+	conn := u.getConn()
+	if conn == nil {
+		return ErrUDPConnNotOpen
+	}
+	return conn.SetDeadline(t)
+}
+
+func (u *udpConnImpl) SetReadDeadline(t time.Time) error {
+	conn := u.getConn()
+	if conn == nil {
+		return ErrUDPConnNotOpen
+	}
+	return conn.SetReadDeadline(t)
+}
+
+func (u *udpConnImpl) SetWriteDeadline(t time.Time) error {
+	conn := u.getConn()
+	if conn == nil {
+		return ErrUDPConnNotOpen
+	}
+	return conn.SetWriteDeadline(t)
+}
+
+func (u *udpConnImpl) Open(ctx context.Context, ip string, port int, ssrc uint32) (string, int, error) {
+	u.connMu.Lock()
+	defer u.connMu.Unlock()
+
+	host := net.JoinHostPort(ip, strconv.Itoa(port))
+	u.config.Logger.Debugf("opening voice udp connection to: %s", host)
+	conn, err := u.config.Dialer.DialContext(ctx, "udp", host)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open voice udp connection: %w", err)
+	}
+	u.conn = conn
+	u.ssrc = ssrc
+	binary.BigEndian.PutUint32(u.packetBuff[8:], ssrc)
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	discovery := make([]byte, ipDiscoveryPacketSize)
+	binary.BigEndian.PutUint16(discovery, ipDiscoveryPacketTypeRequest)
+	binary.BigEndian.PutUint16(discovery[2:], ipDiscoveryPacketLength)
+	binary.BigEndian.PutUint32(discovery[4:], ssrc)
+	if _, err = conn.Write(discovery); err != nil {
+		return "", 0, fmt.Errorf("failed to write ip discovery packet: %w", err)
+	}
+
+	response := make([]byte, ipDiscoveryPacketSize)
+	if _, err = conn.Read(response); err != nil {
+		return "", 0, fmt.Errorf("failed to read ip discovery response: %w", err)
+	}
+
+	if binary.BigEndian.Uint16(response) != ipDiscoveryPacketTypeResponse {
+		return "", 0, fmt.Errorf("invalid ip discovery response type: %d", binary.BigEndian.Uint16(response))
+	}
+
+	address := response[8:72]
+	if i := bytes.IndexByte(address, 0); i >= 0 {
+		address = address[:i]
+	}
+	return string(address), int(binary.BigEndian.Uint16(response[72:74])), nil
+}
+
+func (u *udpConnImpl) Close() error {
+	u.connMu.Lock()
+	defer u.connMu.Unlock()
+	if u.conn == nil {
+		return nil
+	}
+	u.config.Logger.Debug("closing voice udp connection")
+	err := u.conn.Close()
+	u.conn = nil
+	return err
+}
+
+func (u *udpConnImpl) Write(p []byte) (int, error) {
+	conn := u.getConn()
+	if conn == nil {
+		return 0, ErrUDPConnNotOpen
+	}
+	secretKey := u.getSecretKey()
+	if secretKey == nil {
+		return 0, ErrNoSecretKey
+	}
+
+	u.writeMu.Lock()
+	defer u.writeMu.Unlock()
+
+	u.packetBuff[0] = RTPVersion
+	u.packetBuff[1] = OpusPacketType
+	binary.BigEndian.PutUint16(u.packetBuff[2:], u.sequence)
+	binary.BigEndian.PutUint32(u.packetBuff[4:], u.timestamp)
+
+	var nonce [24]byte
+	copy(nonce[:], u.packetBuff[:RTPHeaderSize])
+
+	packet := secretbox.Seal(u.packetBuff[:RTPHeaderSize], p, &nonce, secretKey)
+	if _, err := conn.Write(packet); err != nil {
+		return 0, err
+	}
+	u.sequence++
+	u.timestamp += OpusFrameSize
+	return len(p), nil
+}
+
+func (u *udpConnImpl) ReadPacket() (*Packet, error) {
+	conn := u.getConn()
+	if conn == nil {
+		return nil, ErrUDPConnNotOpen
+	}
+
+	for {
+		n, err := conn.Read(u.receiveBuf)
+		if err != nil {
+			return nil, err
+		}
+		// ignore rtcp & other non opus packets
+		if n < RTPHeaderSize || u.receiveBuf[0]&0xC0 != RTPVersion || u.receiveBuf[1] != OpusPacketType {
+			continue
+		}
+
+		secretKey := u.getSecretKey()
+		if secretKey == nil {
+			return nil, ErrNoSecretKey
+		}
+
+		headerSize := RTPHeaderSize + int(u.receiveBuf[0]&0x0F)*4
+		if n < headerSize {
+			continue
+		}
+
+		copy(u.nonce[:], u.receiveBuf[:RTPHeaderSize])
+		opus, ok := secretbox.Open(nil, u.receiveBuf[headerSize:n], &u.nonce, secretKey)
+		if !ok {
+			return nil, ErrDecryptionFailed
+		}
+
+		// the rtp header extension is part of the encrypted payload, skip it
+		if u.receiveBuf[0]&0x10 != 0 && len(opus) >= 4 {
+			extensionSize := 4 + int(binary.BigEndian.Uint16(opus[2:4]))*4
+			if len(opus) < extensionSize {
+				continue
+			}
+			opus = opus[extensionSize:]
+		}
+
+		return &Packet{
+			Type:      u.receiveBuf[1],
+			Sequence:  binary.BigEndian.Uint16(u.receiveBuf[2:4]),
+			Timestamp: binary.BigEndian.Uint32(u.receiveBuf[4:8]),
+			SSRC:      binary.BigEndian.Uint32(u.receiveBuf[8:12]),
+			Opus:      opus,
+		}, nil
+	}
+}